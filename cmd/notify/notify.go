@@ -22,6 +22,44 @@ func main() {
 
 	runner.ParseOptions(options)
 
+	if options.ConfigSchema {
+		if err := runner.PrintConfigSchema(); err != nil {
+			gologger.Fatal().Msgf("Could not generate config schema: %s\n", err)
+		}
+		return
+	}
+	if options.ValidateConfig {
+		if err := runner.ValidateConfig(options); err != nil {
+			gologger.Fatal().Msgf("Invalid provider config: %s\n", err)
+		}
+		return
+	}
+
+	if options.EncryptConfig {
+		if err := runner.EncryptConfig(options); err != nil {
+			gologger.Fatal().Msgf("Could not encrypt provider config: %s\n", err)
+		}
+		return
+	}
+	if options.DecryptConfig {
+		if err := runner.DecryptConfig(options); err != nil {
+			gologger.Fatal().Msgf("Could not decrypt provider config: %s\n", err)
+		}
+		return
+	}
+	if options.Init {
+		if err := runner.Init(options); err != nil {
+			gologger.Fatal().Msgf("Could not add provider: %s\n", err)
+		}
+		return
+	}
+	if options.ImportURL != "" {
+		if err := runner.ImportURL(options); err != nil {
+			gologger.Fatal().Msgf("Could not import url: %s\n", err)
+		}
+		return
+	}
+
 	notifyRunner, err := runner.NewRunner(options)
 	if err != nil {
 		gologger.Fatal().Msgf("Could not create runner: %s\n", err)
@@ -39,7 +77,17 @@ func main() {
 		}()
 	}()
 
+	if options.Test {
+		err = notifyRunner.Test()
+		notifyRunner.Close()
+		if err != nil {
+			gologger.Fatal().Msgf("Provider test failed: %s\n", err)
+		}
+		return
+	}
+
 	err = notifyRunner.Run()
+	notifyRunner.Close()
 	if err != nil {
 		gologger.Fatal().Msgf("Could not run notifier: %s\n", err)
 	}
@@ -50,11 +98,15 @@ func readConfig() {
 	set.Marshal = true
 	set.SetDescription(`Notify is a general notification tool`)
 	set.StringVar(&cfgFile, "config", "", "notify configuration file")
-	set.StringVarP(&options.ProviderConfig, "provider-config", "pc", "", "provider config path (default: $HOME/.config/notify/provider-config.yaml)")
+	set.StringSliceVarP(&options.ProviderConfig, "provider-config", "pc", nil, "provider config paths merged in order (default: $HOME/.config/notify/provider-config.yaml)", goflags.CommaSeparatedStringSliceOptions)
+	set.StringVarP(&options.ConfigKeyFile, "config-key-file", "ckf", "", "file holding the passphrase of an encrypted provider config (default: $NOTIFY_CONFIG_PASSPHRASE or prompt)")
+	set.StringVar(&options.Profile, "profile", "", "provider config profile selecting the ids and options to use")
+	set.BoolVarP(&options.DisableConfigReload, "disable-config-reload", "dcr", false, "disable reloading the provider config on change or SIGHUP")
 	set.StringVarP(&options.Data, "data", "i", "", "input file to send for notify")
 	set.StringSliceVarP(&options.Providers, "provider", "p", []string{}, "provider to send the notification to (optional)", goflags.NormalizedStringSliceOptions)
 	set.StringSliceVar(&options.IDs, "id", []string{}, "id to send the notification to (optional)", goflags.NormalizedStringSliceOptions)
-	set.IntVarP(&options.RateLimit, "rate-limit", "rl", 1, "maximum number of HTTP requests to send per second")
+	set.StringSliceVar(&options.URLs, "url", []string{}, "shoutrrr service url to send the notification to (optional)", goflags.StringSliceOptions)
+	types.RateLimitVar(set, options, "maximum `number` of notifications to send per second to each provider id without a rate limit in the provider config")
 	set.IntVarP(&options.Delay, "delay", "d", 0, "delay in seconds between each notification")
 	set.BoolVar(&options.Bulk, "bulk", false, "enable bulk processing")
 	set.IntVarP(&options.CharLimit, "char-limit", "cl", 4000, "max character limit per message")
@@ -64,6 +116,14 @@ func readConfig() {
 	set.BoolVar(&options.Version, "version", false, "display version")
 	set.BoolVarP(&options.NoColor, "no-color", "nc", false, "disable colors in output")
 	set.StringVar(&options.Proxy, "proxy", "", "HTTP/SOCKSv5 proxy to use with notify")
+	set.BoolVar(&options.ValidateConfig, "validate-config", false, "validate the provider config and exit")
+	set.BoolVar(&options.ConfigSchema, "config-schema", false, "print the JSON schema of the provider config and exit")
+	set.BoolVar(&options.Test, "test", false, "check connectivity of the selected providers and exit")
+	set.BoolVar(&options.DryRun, "dry-run", false, "print the payload each destination would receive without sending it")
+	set.BoolVar(&options.EncryptConfig, "encrypt-config", false, "encrypt the provider config in place with a passphrase")
+	set.BoolVar(&options.DecryptConfig, "decrypt-config", false, "print the decrypted provider config")
+	set.BoolVar(&options.Init, "init", false, "interactively add a provider to the provider config")
+	set.StringVar(&options.ImportURL, "import-url", "", "print the provider config entry of a shoutrrr or webhook url")
 	set.CallbackVarP(runner.GetUpdateCallback(), "update", "up", "update notify to latest version")
 	set.BoolVarP(&options.DisableUpdateCheck, "disable-update-check", "duc", false, "disable automatic notify update check")
 
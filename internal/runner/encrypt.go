@@ -0,0 +1,169 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/term"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// passphraseEnv holds the passphrase of encrypted provider configs
+const passphraseEnv = "NOTIFY_CONFIG_PASSPHRASE"
+
+// EncryptConfig encrypts the provider config files in place
+func EncryptConfig(options *types.Options) error {
+	if err := setDefaultProviderConfig(options); err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase(options, true)
+	if err != nil {
+		return err
+	}
+	for _, path := range options.ProviderConfig {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if utils.IsEncrypted(data) {
+			return fmt.Errorf("%s is already encrypted", path)
+		}
+		encrypted, err := utils.Encrypt(data, passphrase)
+		if err != nil {
+			return err
+		}
+		if err := replaceFile(path, encrypted); err != nil {
+			return err
+		}
+		gologger.Info().Msgf("Encrypted %s\n", path)
+	}
+	return nil
+}
+
+// DecryptConfig writes the decrypted provider config files to stdout,
+// the plaintext never touches the disk
+func DecryptConfig(options *types.Options) error {
+	if err := setDefaultProviderConfig(options); err != nil {
+		return err
+	}
+	passphrase, err := readPassphrase(options, false)
+	if err != nil {
+		return err
+	}
+	for _, path := range options.ProviderConfig {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if !utils.IsEncrypted(data) {
+			return fmt.Errorf("%s is not encrypted", path)
+		}
+		plaintext, err := utils.Decrypt(data, passphrase)
+		if err != nil {
+			return fmt.Errorf("could not decrypt %s: %w", path, err)
+		}
+		if len(options.ProviderConfig) > 1 {
+			fmt.Fprintf(os.Stdout, "# %s\n", path)
+		}
+		if _, err := os.Stdout.Write(plaintext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// configPassphrase returns the passphrase of the encrypted provider configs,
+// read once on the first encrypted file
+func configPassphrase(options *types.Options) func() ([]byte, error) {
+	var once sync.Once
+	var passphrase []byte
+	var err error
+	return func() ([]byte, error) {
+		once.Do(func() {
+			passphrase, err = readPassphrase(options, false)
+		})
+		return passphrase, err
+	}
+}
+
+// readPassphrase reads the passphrase from the key file, the environment or
+// the terminal, where it is asked twice when confirm is set
+func readPassphrase(options *types.Options, confirm bool) ([]byte, error) {
+	if options.ConfigKeyFile != "" {
+		key, err := os.ReadFile(options.ConfigKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.TrimRight(key, "\r\n"), nil
+	}
+	if passphrase := os.Getenv(passphraseEnv); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+
+	// the terminal is opened directly as stdin usually is the notify input
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	switch {
+	case err == nil:
+		defer tty.Close()
+	case term.IsTerminal(int(os.Stdin.Fd())):
+		tty = os.Stdin
+	default:
+		return nil, fmt.Errorf("set %s or use -config-key-file to give the passphrase of the provider config", passphraseEnv)
+	}
+
+	passphrase, err := askPassphrase(tty, "Provider config passphrase: ")
+	if err != nil {
+		return nil, err
+	}
+	if confirm {
+		again, err := askPassphrase(tty, "Confirm the passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(passphrase, again) {
+			return nil, errors.New("the passphrases don't match")
+		}
+	}
+	return passphrase, nil
+}
+
+func askPassphrase(tty *os.File, prompt string) ([]byte, error) {
+	fmt.Fprint(os.Stderr, prompt)
+	passphrase, err := term.ReadPassword(int(tty.Fd()))
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	return passphrase, nil
+}
+
+// replaceFile atomically replaces the content of the file, readable by its owner only
+func replaceFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,278 @@
+package runner
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/providers"
+	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// Init interactively adds a provider to the provider config. The entry is
+// validated and checked with a test notification, or a dry run of one,
+// before being merged into the first provider config file.
+func Init(options *types.Options) error {
+	path, err := initConfigPath(options)
+	if err != nil {
+		return err
+	}
+	prompt := &prompter{reader: bufio.NewReader(os.Stdin), writer: os.Stdout}
+
+	fmt.Fprintf(prompt.writer, "Adding a provider to %s\n", path)
+	fmt.Fprintf(prompt.writer, "Providers: %s\n", strings.Join(providers.ProviderNames(), ", "))
+	answer, err := prompt.ask("Provider, or a shoutrrr or webhook url to import", "")
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if strings.Contains(answer, "://") {
+		var id string
+		if id, err = prompt.ask("id", ""); err != nil {
+			return err
+		}
+		var providerOptions *providers.ProviderOptions
+		if providerOptions, err = providers.ImportURL(answer, id); err != nil {
+			return err
+		}
+		data, err = providers.MarshalConfig(providerOptions)
+	} else {
+		data, err = askProviderOptions(prompt, answer)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(prompt.writer, "\n%s\n", data)
+
+	if checkErr := checkProviderEntry(prompt, options, data); checkErr != nil {
+		gologger.Error().Msgf("%s\n", checkErr)
+		save, err := prompt.confirm("Save it anyway", false)
+		if err != nil {
+			return err
+		}
+		if !save {
+			return errors.New("the provider config was left unchanged")
+		}
+	}
+
+	var providerOptions providers.ProviderOptions
+	if err := yaml.Unmarshal(data, &providerOptions); err != nil {
+		return err
+	}
+	if err := providers.AppendConfig(path, &providerOptions); err != nil {
+		return err
+	}
+	gologger.Info().Msgf("Saved the provider to %s\n", path)
+	return nil
+}
+
+// ImportURL prints the provider config entry of a shoutrrr or webhook url
+func ImportURL(options *types.Options) error {
+	var id string
+	if len(options.IDs) > 0 {
+		id = options.IDs[0]
+	}
+	providerOptions, err := providers.ImportURL(options.ImportURL, id)
+	if err != nil {
+		return err
+	}
+	data, err := providers.MarshalConfig(providerOptions)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+// initConfigPath returns the provider config file the wizard writes to
+func initConfigPath(options *types.Options) (string, error) {
+	if len(options.ProviderConfig) > 0 {
+		return options.ProviderConfig[0], nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, types.DefaultProviderConfigLocation), nil
+}
+
+// askProviderOptions prompts for the options of the provider and returns
+// its provider config entry
+func askProviderOptions(prompt *prompter, provider string) ([]byte, error) {
+	provider = strings.ToLower(provider)
+	fields, err := providers.ProviderFields(provider)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(prompt.writer, "Options of %s, leave empty to skip, ${env:NAME} and the other secret references can be used\n", provider)
+
+	entry := &yaml.Node{Kind: yaml.MappingNode}
+	for _, field := range fields {
+		defaultValue := ""
+		if field.Name == "id" {
+			defaultValue = provider
+		}
+		for {
+			answer, err := prompt.ask(field.Name+fieldHint(field), defaultValue)
+			if err != nil {
+				return nil, err
+			}
+			if answer == "" {
+				break
+			}
+			value, err := parseField(field, answer)
+			if err != nil {
+				fmt.Fprintf(prompt.writer, "%s\n", err)
+				continue
+			}
+			var valueNode yaml.Node
+			if err := valueNode.Encode(value); err != nil {
+				return nil, err
+			}
+			entry.Content = append(entry.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: field.Name}, &valueNode)
+			break
+		}
+	}
+
+	document := map[string][]*yaml.Node{provider: {entry}}
+	return providers.MarshalConfig(document)
+}
+
+func fieldHint(field providers.OptionField) string {
+	switch field.Kind {
+	case "bool":
+		return " (yes/no)"
+	case "integer":
+		return " (number)"
+	case "list":
+		return " (comma separated)"
+	}
+	return ""
+}
+
+func parseField(field providers.OptionField, answer string) (interface{}, error) {
+	switch field.Kind {
+	case "bool":
+		switch strings.ToLower(answer) {
+		case "y", "yes", "true":
+			return true, nil
+		case "n", "no", "false":
+			return false, nil
+		}
+		return nil, fmt.Errorf("expected yes or no, got %q", answer)
+	case "integer":
+		value, err := strconv.Atoi(answer)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number, got %q", answer)
+		}
+		return value, nil
+	case "list":
+		var values []string
+		for _, value := range strings.Split(answer, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return values, nil
+	}
+	return answer, nil
+}
+
+// checkProviderEntry validates the entry, then either sends a test
+// notification to the provider or dry runs one
+func checkProviderEntry(prompt *prompter, options *types.Options, data []byte) error {
+	if problems := providers.Validate(data); len(problems) > 0 {
+		for _, problem := range problems {
+			gologger.Error().Msgf("%s\n", problem)
+		}
+		return fmt.Errorf("found %d problem(s) in the provider", len(problems))
+	}
+
+	live, err := prompt.confirm("Send a test notification to check it (a dry run otherwise)", true)
+	if err != nil {
+		return err
+	}
+
+	// the entry is loaded from a file like any config to resolve its secret references
+	file, err := os.CreateTemp("", "notify-init-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	providerOptions, err := providers.LoadConfig([]string{file.Name()})
+	if err != nil {
+		return err
+	}
+
+	checkOptions := &types.Options{Proxy: options.Proxy, DryRun: !live}
+	client, err := providers.New(providerOptions, checkOptions)
+	if err != nil {
+		return err
+	}
+	runner := &Runner{options: checkOptions, providers: client}
+	defer runner.Close()
+	if live {
+		return runner.Test()
+	}
+	runner.configureTransport()
+	return runner.sendMessage(utils.TestMessage)
+}
+
+// prompter asks questions on the terminal
+type prompter struct {
+	reader *bufio.Reader
+	writer io.Writer
+}
+
+// ask returns the trimmed answer to the question, or the default value when empty
+func (p *prompter) ask(question, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		fmt.Fprintf(p.writer, "%s [%s]: ", question, defaultValue)
+	} else {
+		fmt.Fprintf(p.writer, "%s: ", question)
+	}
+	answer, err := p.reader.ReadString('\n')
+	if err != nil && (err != io.EOF || answer == "") {
+		return "", err
+	}
+	if answer = strings.TrimSpace(answer); answer == "" {
+		return defaultValue, nil
+	}
+	return answer, nil
+}
+
+// confirm asks a yes or no question
+func (p *prompter) confirm(question string, defaultYes bool) (bool, error) {
+	choices := "y/N"
+	if defaultYes {
+		choices = "Y/n"
+	}
+	answer, err := p.ask(fmt.Sprintf("%s? [%s]", question, choices), "")
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "":
+		return defaultYes, nil
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
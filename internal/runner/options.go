@@ -7,7 +7,10 @@ import (
 	"github.com/projectdiscovery/gologger"
 	"github.com/projectdiscovery/gologger/formatter"
 	"github.com/projectdiscovery/gologger/levels"
+	"github.com/projectdiscovery/gologger/writer"
+	"github.com/projectdiscovery/notify/pkg/providers"
 	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
 	fileutil "github.com/projectdiscovery/utils/file"
 	updateutils "github.com/projectdiscovery/utils/update"
 )
@@ -39,6 +42,9 @@ func ParseOptions(options *types.Options) {
 		}
 	}
 
+	// encrypted provider configs are decrypted in memory when read
+	providers.ConfigPassphrase = configPassphrase(options)
+
 	// Validate the options passed by the user and if any
 	// invalid options have been used, exit.
 	if err := validateOptions(options); err != nil {
@@ -57,6 +63,8 @@ func configureOutput(options *types.Options) {
 	if options.Silent {
 		gologger.DefaultLogger.SetMaxLevel(levels.LevelSilent)
 	}
+	// secrets of the provider config never reach the terminal
+	gologger.DefaultLogger.SetWriter(utils.DefaultRedactor.LogWriter(writer.NewCLI()))
 }
 
 // validateOptions validates the configuration options passed
@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/providers"
+)
+
+// configPollInterval is how often the provider config files are checked for changes
+const configPollInterval = 2 * time.Second
+
+// watchConfig reloads the providers when a provider config file changes
+// or on SIGHUP, until stop is closed
+func (r *Runner) watchConfig(stop <-chan struct{}) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	ticker := time.NewTicker(configPollInterval)
+	defer ticker.Stop()
+
+	fingerprint := configFingerprint(r.baseOptions.ProviderConfig)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-hangup:
+			gologger.Info().Msgf("Received SIGHUP, reloading provider config\n")
+		case <-ticker.C:
+			current := configFingerprint(r.baseOptions.ProviderConfig)
+			if current == fingerprint {
+				continue
+			}
+			gologger.Info().Msgf("Provider config changed, reloading\n")
+		}
+		fingerprint = configFingerprint(r.baseOptions.ProviderConfig)
+		if err := r.reload(); err != nil {
+			gologger.Error().Msgf("Could not reload provider config, keeping the current one: %s\n", err)
+		}
+	}
+}
+
+// reload validates and loads the provider config, then swaps the providers
+// once the in-flight sends are done. The current providers are kept on error
+// and closed after the swap, which delivers their buffered notifications.
+func (r *Runner) reload() error {
+	files, problems, err := validateConfigFiles(r.baseOptions.ProviderConfig)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		gologger.Error().Msgf("%s\n", problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problem(s) in %s", len(problems), strings.Join(files, ", "))
+	}
+
+	options := r.baseOptions
+	providerOptions, err := loadProviderOptions(&options)
+	if err != nil {
+		return err
+	}
+	client, err := providers.New(providerOptions, &options)
+	if err != nil {
+		return err
+	}
+
+	r.mutex.Lock()
+	previous := r.providers
+	r.providers = client
+	r.options = &options
+	r.configureTransport()
+	r.mutex.Unlock()
+
+	previous.Close()
+	gologger.Info().Msgf("Reloaded provider config from %s\n", strings.Join(files, ", "))
+	return nil
+}
+
+// configFingerprint identifies the content of the provider config files by
+// their path, size and modification time
+func configFingerprint(paths []string) string {
+	files, err := providers.ConfigFiles(paths)
+	if err != nil {
+		// a file being written, checked again on the next tick
+		return ""
+	}
+	var fingerprint strings.Builder
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return ""
+		}
+		fmt.Fprintf(&fingerprint, "%s:%d:%d;", file, info.Size(), info.ModTime().UnixNano())
+	}
+	return fingerprint.String()
+}
@@ -3,65 +3,142 @@ package runner
 import (
 	"bufio"
 	"crypto/tls"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/containrrr/shoutrrr"
 	"github.com/pkg/errors"
-	"gopkg.in/yaml.v3"
 
+	"github.com/projectdiscovery/goflags"
 	"github.com/projectdiscovery/gologger"
 	"github.com/projectdiscovery/notify/pkg/providers"
+	shoutrrrprovider "github.com/projectdiscovery/notify/pkg/providers/shoutrrr"
 	"github.com/projectdiscovery/notify/pkg/types"
-	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
 	fileutil "github.com/projectdiscovery/utils/file"
 )
 
 // Runner contains the internal logic of the program
 type Runner struct {
+	// mutex guards the providers and options swapped by a config reload,
+	// sends hold the read lock so that a reload waits for them to finish
+	mutex     sync.RWMutex
 	options   *types.Options
 	providers *providers.Client
+	// baseOptions are the options before the profile is applied
+	baseOptions types.Options
 }
 
 // NewRunner instance
 func NewRunner(options *types.Options) (*Runner, error) {
-	var providerOptions providers.ProviderOptions
-
-	if options.ProviderConfig == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return nil, err
-		}
-		options.ProviderConfig = filepath.Join(home, types.DefaultProviderConfigLocation)
-		gologger.Print().Msgf("Using default provider config: %s\n", options.ProviderConfig)
+	if err := setDefaultProviderConfig(options); err != nil {
+		return nil, err
 	}
+	baseOptions := *options
 
-	reader, err := fileutil.SubstituteConfigFromEnvVars(options.ProviderConfig)
+	providerOptions, err := loadProviderOptions(options)
 	if err != nil {
 		return nil, err
 	}
 
-	if parseErr := yaml.NewDecoder(reader).Decode(&providerOptions); parseErr != nil {
-		return nil, errors.Wrap(parseErr, "could not parse provider config file")
+	shoutrrr.SetLogger(log.New(io.Discard, "", 0))
+
+	prClient, err := providers.New(providerOptions, options)
+	if err != nil {
+		return nil, err
 	}
 
-	shoutrrr.SetLogger(log.New(io.Discard, "", 0))
+	return &Runner{options: options, providers: prClient, baseOptions: baseOptions}, nil
+}
 
-	prClient, err := providers.New(&providerOptions, options)
+// setDefaultProviderConfig falls back to the provider config in the user config directory
+func setDefaultProviderConfig(options *types.Options) error {
+	if len(options.ProviderConfig) > 0 {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return err
+	}
+	defaultConfig := filepath.Join(home, types.DefaultProviderConfigLocation)
+	if len(options.URLs) > 0 && !fileutil.FileExists(defaultConfig) {
+		// the services given with -url are enough to notify
+		return nil
+	}
+	options.ProviderConfig = goflags.StringSlice{defaultConfig}
+	gologger.Print().Msgf("Using default provider config: %s\n", options.ProviderConfig[0])
+	return nil
+}
+
+// loadProviderOptions loads the provider config, applies the profile and
+// adds the services given with -url
+func loadProviderOptions(options *types.Options) (*providers.ProviderOptions, error) {
+	providerOptions, err := providers.LoadConfig(options.ProviderConfig)
 	if err != nil {
 		return nil, err
 	}
+	if err := applyProfile(providerOptions, options); err != nil {
+		return nil, err
+	}
+	addServiceURLs(providerOptions, options)
+	return providerOptions, nil
+}
+
+// addServiceURLs adds the -url services as the shoutrrr ids url-1, url-2...
+// which are notified whatever providers and ids are selected
+func addServiceURLs(providerOptions *providers.ProviderOptions, options *types.Options) {
+	if len(options.URLs) == 0 {
+		return
+	}
+	if len(options.Providers) > 0 {
+		options.Providers = append(options.Providers, "shoutrrr")
+	}
+	for i, serviceURL := range options.URLs {
+		id := fmt.Sprintf("url-%d", i+1)
+		providerOptions.Shoutrrr = append(providerOptions.Shoutrrr, &shoutrrrprovider.Options{ID: id, URL: serviceURL})
+		if len(options.IDs) > 0 {
+			options.IDs = append(options.IDs, id)
+		}
+	}
+}
 
-	return &Runner{options: options, providers: prClient}, nil
+// applyProfile applies the profile selected with -profile to the options
+func applyProfile(providerOptions *providers.ProviderOptions, options *types.Options) error {
+	if options.Profile == "" {
+		return nil
+	}
+	profile, ok := providerOptions.Profiles[options.Profile]
+	if !ok || profile == nil {
+		names := make([]string, 0, len(providerOptions.Profiles))
+		for name := range providerOptions.Profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("profile %q is not defined in the provider config (available: %s)", options.Profile, strings.Join(names, ", "))
+	}
+	profile.Apply(options)
+	gologger.Verbose().Msgf("Using profile %s\n", options.Profile)
+	return nil
 }
 
-// Run polling and notification
-func (r *Runner) Run() error {
+// configureTransport applies the proxy option to the default http client, the
+// rate limits are applied by the providers client to each destination
+func (r *Runner) configureTransport() {
+	if r.options.DryRun {
+		// nothing leaves the machine, requests are printed instead
+		http.DefaultClient.Transport = httpreq.NewDryRunTransport(r.providers.DryRunWriter())
+		return
+	}
+
 	defaultTransport := http.DefaultTransport.(*http.Transport)
 	if r.options.Proxy != "" {
 		proxyurl, err := url.Parse(r.options.Proxy)
@@ -79,9 +156,11 @@ func (r *Runner) Run() error {
 	}
 
 	http.DefaultClient.Transport = defaultTransport
-	if r.options.RateLimit > 0 {
-		http.DefaultClient.Transport = utils.NewThrottledTransport(time.Second, r.options.RateLimit, defaultTransport)
-	}
+}
+
+// Run polling and notification
+func (r *Runner) Run() error {
+	r.configureTransport()
 
 	var inFile *os.File
 	var err error
@@ -119,6 +198,12 @@ func (r *Runner) Run() error {
 
 	br.Split(splitter)
 
+	if !r.options.DisableConfigReload && len(r.baseOptions.ProviderConfig) > 0 {
+		stop := make(chan struct{})
+		defer close(stop)
+		go r.watchConfig(stop)
+	}
+
 	for br.Scan() {
 		msg := br.Text()
 		//nolint:errcheck
@@ -128,11 +213,17 @@ func (r *Runner) Run() error {
 }
 
 func (r *Runner) sendMessage(msg string) error {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
 	if len(msg) > 0 {
 		if r.options.Delay > 0 {
 			time.Sleep(time.Duration(r.options.Delay) * time.Second)
 		}
-		gologger.Silent().Msgf("%s\n", msg)
+		// keep stdout machine readable when it is a delivery target
+		if !r.providers.WritesStdout() {
+			gologger.Silent().Msgf("%s\n", msg)
+		}
 		err := r.providers.Send(msg)
 		if err != nil {
 			return err
@@ -142,4 +233,9 @@ func (r *Runner) sendMessage(msg string) error {
 }
 
 // Close the runner instance
-func (r *Runner) Close() {}
+func (r *Runner) Close() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.providers.Close()
+}
@@ -0,0 +1,41 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Test checks the connectivity of the selected providers and prints
+// a table of the results
+func (r *Runner) Test() error {
+	r.configureTransport()
+
+	results := r.providers.Test()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPROVIDER\tSTATUS\tLATENCY\tERROR")
+	var failed int
+	for _, result := range results {
+		status, errText := "ok", ""
+		if result.Sent {
+			status = "sent"
+		}
+		if result.Err != nil {
+			status = "failed"
+			errText = strings.Join(strings.Fields(result.Err.Error()), " ")
+			failed++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.ID, result.Provider, status, result.Latency.Round(time.Millisecond), errText)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d checks failed", failed, len(results))
+	}
+	return nil
+}
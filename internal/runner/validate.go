@@ -0,0 +1,83 @@
+package runner
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/providers"
+	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// ValidateConfig reports every problem of the provider config and
+// returns an error when at least one was found
+func ValidateConfig(options *types.Options) error {
+	if err := setDefaultProviderConfig(options); err != nil {
+		return err
+	}
+
+	files, problems, err := validateConfigFiles(options.ProviderConfig)
+	if err != nil {
+		return err
+	}
+	if len(options.URLs) > 0 {
+		files = append(files, "-url")
+		problems = append(problems, validateServiceURLs(options.URLs)...)
+	}
+	for _, problem := range problems {
+		gologger.Error().Msgf("%s\n", problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problem(s) in %s", len(problems), strings.Join(files, ", "))
+	}
+	gologger.Info().Msgf("%s is valid\n", strings.Join(files, ", "))
+	return nil
+}
+
+// validateServiceURLs checks the services given with -url, whose secrets
+// are masked in the problems
+func validateServiceURLs(urls []string) []string {
+	var problems []string
+	providerOptions := &providers.ProviderOptions{}
+	addServiceURLs(providerOptions, &types.Options{URLs: urls})
+	utils.DefaultRedactor.Add(providerOptions.Secrets()...)
+	for _, options := range providerOptions.Shoutrrr {
+		if err := options.Validate(); err != nil {
+			problems = append(problems, fmt.Sprintf("-url %s: %s", options.ID, err))
+		}
+	}
+	return problems
+}
+
+// validateConfigFiles validates the provider config files along with their
+// includes and returns the files and the problems prefixed with their file
+func validateConfigFiles(paths []string) ([]string, []string, error) {
+	files, err := providers.ConfigFiles(paths)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var problems []string
+	for _, file := range files {
+		data, err := providers.ConfigData(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, problem := range providers.Validate(data) {
+			problems = append(problems, fmt.Sprintf("%s: %s", file, problem))
+		}
+	}
+	return files, problems, nil
+}
+
+// PrintConfigSchema writes the JSON Schema of the provider config to stdout
+func PrintConfigSchema() error {
+	schema, err := providers.JSONSchema()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(os.Stdout, string(schema))
+	return err
+}
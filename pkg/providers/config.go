@@ -0,0 +1,379 @@
+package providers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// Profile selects provider ids and notify options for a pipeline sharing
+// the provider config, chosen with -profile
+type Profile struct {
+	Providers     []string `yaml:"providers,omitempty"`
+	IDs           []string `yaml:"ids,omitempty"`
+	RateLimit     int      `yaml:"rate_limit,omitempty"`
+	Delay         int      `yaml:"delay,omitempty"`
+	MessageFormat string   `yaml:"message_format,omitempty"`
+	Bulk          bool     `yaml:"bulk,omitempty"`
+}
+
+// Apply sets the options of the profile that weren't given on the command line
+func (p *Profile) Apply(options *types.Options) {
+	if len(options.Providers) == 0 {
+		options.Providers = append(options.Providers, p.Providers...)
+	}
+	if len(options.IDs) == 0 {
+		options.IDs = append(options.IDs, p.IDs...)
+	}
+	if !options.RateLimitSet && p.RateLimit != 0 {
+		options.RateLimit = p.RateLimit
+	}
+	if options.Delay == 0 {
+		options.Delay = p.Delay
+	}
+	if options.MessageFormat == "" {
+		options.MessageFormat = p.MessageFormat
+	}
+	options.Bulk = options.Bulk || p.Bulk
+}
+
+// ConfigPassphrase returns the passphrase of the encrypted provider config
+// files, it is only called when such a file is read
+var ConfigPassphrase = func() ([]byte, error) {
+	return nil, errors.New("no passphrase was given")
+}
+
+// LoadConfig reads and merges the provider config files in order. Files
+// listed by include are merged before the file including them, the options
+// of a provider are appended and an id defined again replaces the previous
+// definition, as do profiles of the same name and the rate limits of a provider.
+func LoadConfig(paths []string) (*ProviderOptions, error) {
+	files, err := ConfigFiles(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &ProviderOptions{}
+	for _, file := range files {
+		document, err := readConfigFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if document.Kind == 0 {
+			continue
+		}
+		var providerOptions ProviderOptions
+		if err := document.Decode(&providerOptions); err != nil {
+			return nil, errors.Wrapf(err, "could not parse provider config file %s", file)
+		}
+		merged.merge(&providerOptions)
+	}
+	merged.Include = nil
+	return merged, nil
+}
+
+// ConfigFiles returns the provider config files in merge order, each
+// preceded by the files it includes. Include patterns are globs relative
+// to the directory of the including file.
+func ConfigFiles(paths []string) ([]string, error) {
+	var files []string
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		if err := expandIncludes(path, nil, seen, &files); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func expandIncludes(path string, stack []string, seen map[string]bool, files *[]string) error {
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	for _, including := range stack {
+		if including == absolute {
+			return fmt.Errorf("include cycle: %s -> %s", strings.Join(stack, " -> "), absolute)
+		}
+	}
+	if seen[absolute] {
+		return nil
+	}
+
+	includes, err := readIncludes(path)
+	if err != nil {
+		return err
+	}
+	for _, pattern := range includes {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(filepath.Dir(path), pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return errors.Wrapf(err, "invalid include %q in %s", pattern, path)
+		}
+		if len(matches) == 0 && !hasGlobMeta(pattern) {
+			return fmt.Errorf("included file %s of %s does not exist", pattern, path)
+		}
+		sort.Strings(matches)
+		for _, match := range matches {
+			if err := expandIncludes(match, append(stack, absolute), seen, files); err != nil {
+				return err
+			}
+		}
+	}
+
+	seen[absolute] = true
+	*files = append(*files, path)
+	return nil
+}
+
+// readIncludes returns the include patterns of a config file
+func readIncludes(path string) ([]string, error) {
+	data, err := ConfigData(path)
+	if err != nil {
+		return nil, err
+	}
+	var config struct {
+		Include []string `yaml:"include"`
+	}
+	if err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&config); err != nil && err != io.EOF {
+		return nil, errors.Wrapf(err, "could not parse provider config file %s", path)
+	}
+	return config.Include, nil
+}
+
+func hasGlobMeta(pattern string) bool {
+	return strings.ContainsAny(pattern, `*?[`)
+}
+
+// readConfigFile reads a provider config file with the environment variables
+// substituted and the secret references resolved, the resolved values are
+// masked in every log from then on
+func readConfigFile(path string) (*yaml.Node, error) {
+	data, err := ConfigData(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&document); err != nil {
+		if err == io.EOF {
+			// an empty file, e.g. one only meant to be included
+			return &document, nil
+		}
+		return nil, errors.Wrapf(err, "could not parse provider config file %s", path)
+	}
+
+	secrets, err := utils.ResolveSecretRefs(&document)
+	utils.DefaultRedactor.Add(secrets...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not resolve secrets of provider config file %s", path)
+	}
+	return &document, nil
+}
+
+// ConfigData returns the content of a provider config file, decrypted in
+// memory when it was encrypted with -encrypt-config, with the $NAME
+// environment variables substituted
+func ConfigData(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if utils.IsEncrypted(data) {
+		if data, err = decryptConfig(path, data); err != nil {
+			return nil, err
+		}
+	}
+	return substituteEnvVars(data), nil
+}
+
+// decryptConfig opens an encrypted provider config file with ConfigPassphrase
+func decryptConfig(path string, data []byte) ([]byte, error) {
+	passphrase, err := ConfigPassphrase()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt provider config file %s", path)
+	}
+	plaintext, err := utils.Decrypt(data, passphrase)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decrypt provider config file %s", path)
+	}
+	return plaintext, nil
+}
+
+// substituteEnvVars replaces the words starting with $ by the environment
+// variable of that name when it is set, as the config file helpers of
+// projectdiscovery/utils do
+func substituteEnvVars(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	for i, line := range lines {
+		for _, word := range strings.Fields(line) {
+			word = strings.Trim(word, `"`)
+			if !strings.HasPrefix(word, "$") {
+				continue
+			}
+			if value := os.Getenv(strings.TrimPrefix(word, "$")); value != "" {
+				line = strings.Replace(line, word, value, 1)
+			}
+		}
+		lines[i] = line
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// merge appends the options of other, replacing the options of the same id
+func (p *ProviderOptions) merge(other *ProviderOptions) {
+	dst, src := reflect.ValueOf(p).Elem(), reflect.ValueOf(other).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Field(i)
+		if field.Kind() != reflect.Slice || field.Type().Elem().Kind() != reflect.Pointer {
+			continue
+		}
+		for j := 0; j < src.Field(i).Len(); j++ {
+			option := src.Field(i).Index(j)
+			if option.IsNil() {
+				continue
+			}
+			if index := indexOfID(field, option.Elem().FieldByName("ID").String()); index >= 0 {
+				field.Index(index).Set(option)
+				continue
+			}
+			field.Set(reflect.Append(field, option))
+		}
+	}
+
+	for name, profile := range other.Profiles {
+		if p.Profiles == nil {
+			p.Profiles = make(map[string]*Profile)
+		}
+		p.Profiles[name] = profile
+	}
+	for name, limit := range other.RateLimits {
+		if p.RateLimits == nil {
+			p.RateLimits = make(map[string]*ProviderRateLimit)
+		}
+		p.RateLimits[name] = limit
+	}
+}
+
+func indexOfID(options reflect.Value, id string) int {
+	if id == "" {
+		return -1
+	}
+	for i := 0; i < options.Len(); i++ {
+		if option := options.Index(i); !option.IsNil() && option.Elem().FieldByName("ID").String() == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// AppendConfig adds the options to the provider config file, replacing the
+// options of the same provider and id, and keeps the file readable by its
+// owner only. The rest of the file is written back untouched, including
+// its comments and secret references, and encrypted files stay encrypted.
+func AppendConfig(path string, providerOptions *ProviderOptions) error {
+	var document yaml.Node
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	encrypted := utils.IsEncrypted(data)
+	if encrypted {
+		if data, err = decryptConfig(path, data); err != nil {
+			return err
+		}
+	}
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return errors.Wrapf(err, "could not parse provider config file %s", path)
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	root := document.Content[0]
+
+	var added yaml.Node
+	if err := added.Encode(providerOptions); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(added.Content); i += 2 {
+		key, value := added.Content[i], added.Content[i+1]
+		existing := mappingValue(root, key.Value)
+		if existing == nil || existing.Kind != yaml.SequenceNode || value.Kind != yaml.SequenceNode {
+			setMappingValue(root, key, value)
+			continue
+		}
+		for _, item := range value.Content {
+			replaceOrAppendID(existing, item)
+		}
+	}
+
+	data, err = MarshalConfig(&document)
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		passphrase, err := ConfigPassphrase()
+		if err != nil {
+			return err
+		}
+		if data, err = utils.Encrypt(data, passphrase); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(path, 0600)
+}
+
+// MarshalConfig encodes provider config with the two space indentation of the documentation
+func MarshalConfig(v interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func setMappingValue(node, key, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key.Value {
+			node.Content[i+1] = value
+			return
+		}
+	}
+	node.Content = append(node.Content, key, value)
+}
+
+func replaceOrAppendID(list, item *yaml.Node) {
+	if id := mappingValue(item, "id"); id != nil && id.Value != "" {
+		for i, existing := range list.Content {
+			if existingID := mappingValue(resolveAlias(existing), "id"); existingID != nil && existingID.Value == id.Value {
+				list.Content[i] = item
+				return
+			}
+		}
+	}
+	list.Content = append(list.Content, item)
+}
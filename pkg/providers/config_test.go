@@ -0,0 +1,210 @@
+package providers
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/projectdiscovery/goflags"
+	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, filepath.Join(dir, "teams", "blue.yaml"), `slack:
+  - id: "blue"
+    slack_webhook_url: "https://hooks.slack.com/services/blue"
+`)
+	writeConfig(t, filepath.Join(dir, "teams", "red.yaml"), `slack:
+  - id: "red"
+    slack_webhook_url: "https://hooks.slack.com/services/red"
+`)
+	writeConfig(t, filepath.Join(dir, "base.yaml"), `include: ["teams/*.yaml"]
+slack:
+  - id: "base"
+    slack_webhook_url: "https://hooks.slack.com/services/base"
+profiles:
+  bounty:
+    ids: ["red"]
+    rate_limit: 1
+`)
+	writeConfig(t, filepath.Join(dir, "override.yaml"), `slack:
+  - id: "red"
+    slack_webhook_url: "https://hooks.slack.com/services/red-override"
+profiles:
+  bounty:
+    ids: ["red", "blue"]
+    message_format: "bounty: {{data}}"
+`)
+
+	providerOptions, err := LoadConfig([]string{filepath.Join(dir, "base.yaml"), filepath.Join(dir, "override.yaml")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, options := range providerOptions.Slack {
+		got = append(got, options.ID+"="+options.SlackWebHookURL)
+	}
+	expected := []string{
+		"blue=https://hooks.slack.com/services/blue",
+		"red=https://hooks.slack.com/services/red-override",
+		"base=https://hooks.slack.com/services/base",
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, got)
+			break
+		}
+	}
+
+	options := &types.Options{RateLimit: 5}
+	providerOptions.Profiles["bounty"].Apply(options)
+	if len(options.IDs) != 2 || options.RateLimit != 5 || options.MessageFormat != "bounty: {{data}}" {
+		t.Errorf("unexpected options after applying the profile: ids=%v rate_limit=%d message_format=%q", options.IDs, options.RateLimit, options.MessageFormat)
+	}
+}
+
+func TestProfileRateLimit(t *testing.T) {
+	profile := &Profile{RateLimit: 2}
+	parse := func(args ...string) *types.Options {
+		options := &types.Options{}
+		set := goflags.NewFlagSet()
+		types.RateLimitVar(set, options, "rate limit")
+		if err := set.CommandLine.Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return options
+	}
+
+	options := parse()
+	if options.RateLimit != types.DefaultRateLimit {
+		t.Fatalf("expected the default rate limit, got %d", options.RateLimit)
+	}
+	profile.Apply(options)
+	if options.RateLimit != 2 {
+		t.Errorf("expected the profile to replace the default rate limit, got %d", options.RateLimit)
+	}
+
+	for _, args := range [][]string{{"-rl", "0"}, {"-rate-limit", "5"}} {
+		options := parse(args...)
+		expected := options.RateLimit
+		profile.Apply(options)
+		if options.RateLimit != expected {
+			t.Errorf("expected %v to take precedence over the profile, got %d", args, options.RateLimit)
+		}
+	}
+}
+
+func TestLoadConfigIncludeCycle(t *testing.T) {
+	dir := t.TempDir()
+	writeConfig(t, filepath.Join(dir, "a.yaml"), `include: ["b.yaml"]`)
+	writeConfig(t, filepath.Join(dir, "b.yaml"), `include: ["a.yaml"]`)
+	if _, err := LoadConfig([]string{filepath.Join(dir, "a.yaml")}); err == nil {
+		t.Error("expected an include cycle error")
+	}
+}
+
+func TestAppendConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "provider-config.yaml")
+	writeConfig(t, path, `# shared config
+slack:
+  - id: "team" # replaced
+    slack_webhook_url: "https://hooks.slack.com/services/old"
+  - id: "other"
+    slack_webhook_url: ${env:SLACK_WEBHOOK_URL}
+`)
+
+	providerOptions, err := ImportURL("https://hooks.slack.com/services/new", "team")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := AppendConfig(path, providerOptions); err != nil {
+		t.Fatal(err)
+	}
+	ntfy, _ := ImportURL("ntfy://ntfy.sh/alerts", "")
+	if err := AppendConfig(path, ntfy); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `# shared config
+slack:
+  - id: team
+    slack_webhook_url: https://hooks.slack.com/services/new
+  - id: "other"
+    slack_webhook_url: ${env:SLACK_WEBHOOK_URL}
+ntfy:
+  - id: ntfy
+    ntfy_server_url: https://ntfy.sh
+    ntfy_topic: alerts
+`
+	if string(data) != expected {
+		t.Errorf("unexpected config:\n%s\nexpected:\n%s", data, expected)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("expected 0600 permissions, got %v", info.Mode().Perm())
+	}
+}
+
+func TestLoadEncryptedConfig(t *testing.T) {
+	previous := ConfigPassphrase
+	defer func() { ConfigPassphrase = previous }()
+	ConfigPassphrase = func() ([]byte, error) { return []byte("passphrase"), nil }
+
+	dir := t.TempDir()
+	encrypted, err := utils.Encrypt([]byte(`slack:
+  - id: "secret"
+    slack_webhook_url: "https://hooks.slack.com/services/secret"
+`), []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeConfig(t, filepath.Join(dir, "secret.yaml"), string(encrypted))
+	writeConfig(t, filepath.Join(dir, "base.yaml"), `include: ["secret.yaml"]
+stdout:
+  - id: "out"
+`)
+
+	providerOptions, err := LoadConfig([]string{filepath.Join(dir, "base.yaml")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(providerOptions.Slack) != 1 || providerOptions.Slack[0].SlackWebHookURL != "https://hooks.slack.com/services/secret" {
+		t.Errorf("unexpected slack options %+v", providerOptions.Slack)
+	}
+
+	added, _ := ImportURL("ntfy://ntfy.sh/alerts", "")
+	if err := AppendConfig(filepath.Join(dir, "secret.yaml"), added); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "secret.yaml"))
+	if !utils.IsEncrypted(data) {
+		t.Fatalf("expected the config to stay encrypted, got:\n%s", data)
+	}
+	if providerOptions, err = LoadConfig([]string{filepath.Join(dir, "secret.yaml")}); err != nil || len(providerOptions.Ntfy) != 1 {
+		t.Errorf("expected the added ntfy options, got %v, %v", providerOptions, err)
+	}
+
+	ConfigPassphrase = func() ([]byte, error) { return []byte("wrong"), nil }
+	if _, err := LoadConfig([]string{filepath.Join(dir, "base.yaml")}); !errors.Is(err, utils.ErrWrongPassphrase) {
+		t.Errorf("expected a wrong passphrase error, got %v", err)
+	}
+}
+
+func writeConfig(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,9 +4,9 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig"
 	"github.com/pkg/errors"
@@ -30,6 +30,39 @@ type Options struct {
 	CustomHeaders    map[string]string `yaml:"custom_headers,omitempty"`
 	CustomFormat     string            `yaml:"custom_format,omitempty"`
 	CustomSprig      string            `yaml:"custom_sprig,omitempty"`
+
+	// HMAC signature of the request body
+	CustomHMACSecret          string `yaml:"custom_hmac_secret,omitempty"`
+	CustomHMACAlgorithm       string `yaml:"custom_hmac_algorithm,omitempty"`
+	CustomHMACStyle           string `yaml:"custom_hmac_style,omitempty"`
+	CustomHMACHeader          string `yaml:"custom_hmac_header,omitempty"`
+	CustomHMACTimestampHeader string `yaml:"custom_hmac_timestamp_header,omitempty"`
+
+	// short-lived JWT sent as bearer token
+	CustomJWTKeyFile   string        `yaml:"custom_jwt_key_file,omitempty"`
+	CustomJWTAlgorithm string        `yaml:"custom_jwt_algorithm,omitempty"`
+	CustomJWTIssuer    string        `yaml:"custom_jwt_issuer,omitempty"`
+	CustomJWTSubject   string        `yaml:"custom_jwt_subject,omitempty"`
+	CustomJWTAudience  string        `yaml:"custom_jwt_audience,omitempty"`
+	CustomJWTTTL       time.Duration `yaml:"custom_jwt_ttl,omitempty"`
+
+	// mutual TLS
+	CustomTLSCert     string `yaml:"custom_tls_cert,omitempty"`
+	CustomTLSKey      string `yaml:"custom_tls_key,omitempty"`
+	CustomTLSCA       string `yaml:"custom_tls_ca,omitempty"`
+	CustomTLSInsecure bool   `yaml:"custom_tls_insecure,omitempty"`
+
+	// request shaping, the url, header and query values are templated
+	CustomQuery    map[string]string `yaml:"custom_query,omitempty"`
+	CustomUsername string            `yaml:"custom_username,omitempty"`
+	CustomPassword string            `yaml:"custom_password,omitempty"`
+	CustomTimeout  time.Duration     `yaml:"custom_timeout,omitempty"`
+
+	// response validation, statuses are codes ("202") or ranges ("200-299", "2xx")
+	CustomSuccessStatus      []string          `yaml:"custom_success_status,omitempty"`
+	CustomResponseAssertions map[string]string `yaml:"custom_response_assertions,omitempty"`
+
+	client *httpreq.Client
 }
 
 func New(options []*Options, ids []string) (*Provider, error) {
@@ -69,7 +102,7 @@ func (p *Provider) Send(message, CliFormat string) error {
 			var buf bytes.Buffer
 			err = tmpl.Execute(&buf, data)
 			if err != nil {
-				err = errors.Wrap(err, fmt.Sprintf("failed to execute custom sprig template for id: %s: %s", pr.ID, data))
+				err = errors.Wrap(err, fmt.Sprintf("failed to execute custom sprig template for id: %s ", pr.ID))
 				CustomErr = multierr.Append(CustomErr, err)
 				continue
 			}
@@ -78,7 +111,7 @@ func (p *Provider) Send(message, CliFormat string) error {
 			// Escape the message to a JSON string
 			b, err := json.Marshal(message)
 			if err != nil {
-				return errors.Wrap(err, fmt.Sprintf("failed to escape message to JSON for id: %s: %s", pr.ID, message))
+				return errors.Wrap(err, fmt.Sprintf("failed to escape message to JSON for id: %s ", pr.ID))
 			}
 			dataJsonString := string(b)
 
@@ -89,26 +122,34 @@ func (p *Provider) Send(message, CliFormat string) error {
 			msg = utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.CustomFormat), p.counter)
 		}
 
-		body := bytes.NewBufferString(msg)
-
-		r, err := http.NewRequest(pr.CustomMethod, pr.CustomWebhookURL, body)
+		response, err := pr.send(msg, message, p.counter)
 		if err != nil {
-			err = errors.Wrap(err, fmt.Sprintf("failed to send custom notification for id: %s: %s", pr.ID, msg))
+			err = errors.Wrap(err, fmt.Sprintf("failed to send custom notification for id: %s ", pr.ID))
 			CustomErr = multierr.Append(CustomErr, err)
 			continue
 		}
+		gologger.Verbose().Msgf("custom notification sent for id: %s (response: %s)", pr.ID, response)
+	}
+	return CustomErr
+}
 
-		for k, v := range pr.CustomHeaders {
-			r.Header.Set(k, v)
-		}
-
-		_, err = httpreq.NewClient().Do(r)
-		if err != nil {
-			err = errors.Wrap(err, fmt.Sprintf("failed to send custom notification for id: %s: %s", pr.ID, msg))
-			CustomErr = multierr.Append(CustomErr, err)
-			continue
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("custom_webhook_url", options.CustomWebhookURL),
+		utils.ValidURL("custom_webhook_url", options.CustomWebhookURL),
+		utils.OneOf("custom_hmac_algorithm", options.CustomHMACAlgorithm, "sha256", "sha512"),
+		utils.OneOf("custom_hmac_style", options.CustomHMACStyle, hmacStyleGithub, hmacStyleStandard),
+		utils.OneOf("custom_jwt_algorithm", options.CustomJWTAlgorithm,
+			"HS256", "HS384", "HS512", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512"),
+	)
+	for _, status := range options.CustomSuccessStatus {
+		if _, _, statusErr := parseStatusRange(status); statusErr != nil {
+			err = multierr.Append(err, utils.NewFieldError("custom_success_status", "%v", statusErr))
 		}
-		gologger.Verbose().Msgf("custom notification sent for id: %s: %s", pr.ID, msg)
 	}
-	return CustomErr
+	if (options.CustomTLSCert == "") != (options.CustomTLSKey == "") {
+		err = multierr.Append(err, utils.NewFieldError("custom_tls_key", "custom_tls_cert and custom_tls_key must be set together"))
+	}
+	return err
 }
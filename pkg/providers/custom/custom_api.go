@@ -0,0 +1,131 @@
+package custom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// maxResponseSnippet is the amount of response body kept for logs and errors
+const maxResponseSnippet = 256
+
+func (options *Options) send(msg, rawMessage string, counter int) (string, error) {
+	body := []byte(msg)
+
+	r, err := options.newRequest(body, rawMessage, counter)
+	if err != nil {
+		return "", err
+	}
+
+	if options.CustomHMACSecret != "" {
+		if err := options.signHMAC(r, body); err != nil {
+			return "", err
+		}
+	}
+	if options.CustomJWTKeyFile != "" {
+		token, err := options.jwtToken()
+		if err != nil {
+			return "", errors.Wrap(err, "could not sign jwt")
+		}
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	if options.client == nil {
+		tlsConfig, err := utils.NewTLSConfig(options.CustomTLSCA, options.CustomTLSCert, options.CustomTLSKey, options.CustomTLSInsecure)
+		if err != nil {
+			return "", err
+		}
+		options.client = httpreq.NewClient()
+		if tlsConfig != nil {
+			options.client = httpreq.NewTLSClient(tlsConfig)
+		}
+	}
+
+	if options.CustomTimeout > 0 {
+		ctx, cancel := context.WithTimeout(r.Context(), options.CustomTimeout)
+		defer cancel()
+		r = r.WithContext(ctx)
+	}
+
+	resp, err := options.client.Do(r)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrap(err, "could not read response")
+	}
+	summary := fmt.Sprintf("%d %s", resp.StatusCode, snippet(respBody))
+
+	success, err := isSuccessStatus(options.CustomSuccessStatus, resp.StatusCode)
+	if err != nil {
+		return "", err
+	}
+	if !success {
+		return "", fmt.Errorf("unexpected status code %s", summary)
+	}
+	if err := assertResponse(options.CustomResponseAssertions, respBody); err != nil {
+		return "", fmt.Errorf("%v in response %s", err, summary)
+	}
+	return summary, nil
+}
+
+// newRequest builds the request from the templated url, query parameters and headers
+func (options *Options) newRequest(body []byte, rawMessage string, counter int) (*http.Request, error) {
+	webhookURL, err := utils.RenderTemplate(options.CustomWebhookURL, rawMessage, counter)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not render custom_webhook_url")
+	}
+	if len(options.CustomQuery) > 0 {
+		u, err := url.Parse(strings.TrimSpace(webhookURL))
+		if err != nil {
+			return nil, err
+		}
+		query := u.Query()
+		for k, v := range options.CustomQuery {
+			value, err := utils.RenderTemplate(v, rawMessage, counter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not render query parameter %s", k)
+			}
+			query.Set(k, value)
+		}
+		u.RawQuery = query.Encode()
+		webhookURL = u.String()
+	}
+
+	r, err := http.NewRequest(options.CustomMethod, strings.TrimSpace(webhookURL), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range options.CustomHeaders {
+		value, err := utils.RenderTemplate(v, rawMessage, counter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not render header %s", k)
+		}
+		r.Header.Set(k, value)
+	}
+	if options.CustomUsername != "" || options.CustomPassword != "" {
+		r.SetBasicAuth(options.CustomUsername, options.CustomPassword)
+	}
+	return r, nil
+}
+
+func snippet(body []byte) string {
+	text := strings.Join(strings.Fields(string(body)), " ")
+	if len(text) > maxResponseSnippet {
+		text = text[:maxResponseSnippet] + "..."
+	}
+	return text
+}
@@ -0,0 +1,154 @@
+package custom
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// isSuccessStatus reports whether the status matches one of the codes
+// ("202") or ranges ("200-299", "2xx"), any 2xx status is accepted when
+// no status is configured
+func isSuccessStatus(specs []string, status int) (bool, error) {
+	if len(specs) == 0 {
+		return status >= 200 && status < 300, nil
+	}
+	for _, spec := range specs {
+		low, high, err := parseStatusRange(spec)
+		if err != nil {
+			return false, err
+		}
+		if status >= low && status <= high {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func parseStatusRange(spec string) (int, int, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if len(spec) == 3 && strings.HasSuffix(spec, "xx") {
+		class, err := strconv.Atoi(spec[:1])
+		if err == nil {
+			return class * 100, class*100 + 99, nil
+		}
+	}
+	if low, high, ok := strings.Cut(spec, "-"); ok {
+		lowCode, lowErr := strconv.Atoi(strings.TrimSpace(low))
+		highCode, highErr := strconv.Atoi(strings.TrimSpace(high))
+		if lowErr == nil && highErr == nil && lowCode <= highCode {
+			return lowCode, highCode, nil
+		}
+	}
+	if code, err := strconv.Atoi(spec); err == nil {
+		return code, code, nil
+	}
+	return 0, 0, fmt.Errorf("invalid custom_success_status %q", spec)
+}
+
+// assertResponse checks the JSON response body against path/value assertions,
+// an empty expected value only requires the path to exist
+func assertResponse(assertions map[string]string, body []byte) error {
+	if len(assertions) == 0 {
+		return nil
+	}
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return fmt.Errorf("response is not valid JSON: %v", err)
+	}
+
+	paths := make([]string, 0, len(assertions))
+	for path := range assertions {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		value, err := lookupJSONPath(document, path)
+		if err != nil {
+			return err
+		}
+		expected := assertions[path]
+		if expected == "" {
+			continue
+		}
+		if actual := jsonValueString(value); actual != expected {
+			return fmt.Errorf("assertion %s failed: expected %q, got %q", path, expected, actual)
+		}
+	}
+	return nil
+}
+
+// lookupJSONPath resolves the dot and bracket subset of JSONPath,
+// e.g. $.data.items[0].id or $['ok']
+func lookupJSONPath(document interface{}, path string) (interface{}, error) {
+	rest := strings.TrimPrefix(strings.TrimSpace(path), "$")
+	current := document
+
+	for rest != "" {
+		var key string
+		index := -1
+		switch {
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			key, rest = rest[:end], rest[end:]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %s", path)
+			}
+			key, rest = rest[2:end], rest[end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("invalid path %s", path)
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid index in path %s", path)
+			}
+			index, rest = i, rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path %s", path)
+		}
+
+		if index >= 0 {
+			items, ok := current.([]interface{})
+			if !ok || index >= len(items) {
+				return nil, fmt.Errorf("path %s not found", path)
+			}
+			current = items[index]
+			continue
+		}
+		object, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+		if current, ok = object[key]; !ok {
+			return nil, fmt.Errorf("path %s not found", path)
+		}
+	}
+	return current, nil
+}
+
+func jsonValueString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
@@ -0,0 +1,236 @@
+package custom
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultHMACHeader = "X-Notify-Signature"
+	defaultJWTTTL     = 5 * time.Minute
+
+	hmacStyleGithub   = "github"
+	hmacStyleStandard = "standard"
+)
+
+// jwtAlgorithms are the supported custom_jwt_algorithm values and their hash
+var jwtAlgorithms = map[string]crypto.Hash{
+	"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+	"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+	"ES256": crypto.SHA256, "ES384": crypto.SHA384, "ES512": crypto.SHA512,
+}
+
+// signHMAC adds the HMAC signature headers of the body to the request.
+//
+// The github style sends "<algorithm>=<hex digest>" of the body (or of
+// "<timestamp>.<body>" when a timestamp header is configured), the standard
+// style follows https://www.standardwebhooks.com with the webhook-id,
+// webhook-timestamp and webhook-signature headers.
+func (options *Options) signHMAC(r *http.Request, body []byte) error {
+	algorithm := strings.ToLower(options.CustomHMACAlgorithm)
+	if algorithm == "" {
+		algorithm = "sha256"
+	}
+	var newHash func() hash.Hash
+	switch algorithm {
+	case "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return fmt.Errorf("unsupported custom_hmac_algorithm %q, expected sha256 or sha512", options.CustomHMACAlgorithm)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	switch strings.ToLower(options.CustomHMACStyle) {
+	case "", hmacStyleGithub:
+		payload := body
+		if options.CustomHMACTimestampHeader != "" {
+			payload = append([]byte(timestamp+"."), body...)
+			r.Header.Set(options.CustomHMACTimestampHeader, timestamp)
+		}
+		mac := hmac.New(newHash, []byte(options.CustomHMACSecret))
+		mac.Write(payload)
+		header := options.CustomHMACHeader
+		if header == "" {
+			header = defaultHMACHeader
+		}
+		r.Header.Set(header, algorithm+"="+hex.EncodeToString(mac.Sum(nil)))
+	case hmacStyleStandard:
+		if algorithm != "sha256" {
+			return fmt.Errorf("standard webhooks signatures only support sha256")
+		}
+		secret := []byte(options.CustomHMACSecret)
+		if encoded, ok := strings.CutPrefix(options.CustomHMACSecret, "whsec_"); ok {
+			decoded, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return fmt.Errorf("invalid standard webhooks secret: %v", err)
+			}
+			secret = decoded
+		}
+		id, err := randomID()
+		if err != nil {
+			return err
+		}
+		id = "msg_" + id
+		mac := hmac.New(newHash, secret)
+		mac.Write([]byte(id + "." + timestamp + "."))
+		mac.Write(body)
+		r.Header.Set("webhook-id", id)
+		r.Header.Set("webhook-timestamp", timestamp)
+		r.Header.Set("webhook-signature", "v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	default:
+		return fmt.Errorf("unsupported custom_hmac_style %q, expected github or standard", options.CustomHMACStyle)
+	}
+	return nil
+}
+
+// jwtToken returns a short-lived JWT signed with the configured key file,
+// which holds either a PEM encoded RSA/ECDSA private key or a raw HMAC secret
+func (options *Options) jwtToken() (string, error) {
+	keyData, err := os.ReadFile(options.CustomJWTKeyFile)
+	if err != nil {
+		return "", err
+	}
+	var key interface{} = []byte(strings.TrimSpace(string(keyData)))
+	if block, _ := pem.Decode(keyData); block != nil {
+		if key, err = parsePrivateKey(block); err != nil {
+			return "", err
+		}
+	}
+
+	algorithm := strings.ToUpper(options.CustomJWTAlgorithm)
+	if algorithm == "" {
+		switch k := key.(type) {
+		case *rsa.PrivateKey:
+			algorithm = "RS256"
+		case *ecdsa.PrivateKey:
+			algorithm = "ES" + strconv.Itoa(k.Curve.Params().BitSize)
+			if k.Curve.Params().BitSize == 521 {
+				algorithm = "ES512"
+			}
+		default:
+			algorithm = "HS256"
+		}
+	}
+
+	ttl := options.CustomJWTTTL
+	if ttl <= 0 {
+		ttl = defaultJWTTTL
+	}
+	jti, err := randomID()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iat": now.Unix(),
+		"exp": now.Add(ttl).Unix(),
+		"jti": jti,
+	}
+	if options.CustomJWTIssuer != "" {
+		claims["iss"] = options.CustomJWTIssuer
+	}
+	if options.CustomJWTSubject != "" {
+		claims["sub"] = options.CustomJWTSubject
+	}
+	if options.CustomJWTAudience != "" {
+		claims["aud"] = options.CustomJWTAudience
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": algorithm, "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	signature, err := signJWT(algorithm, key, []byte(signingInput))
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func signJWT(algorithm string, key interface{}, input []byte) ([]byte, error) {
+	hashFunc, ok := jwtAlgorithms[algorithm]
+	if !ok {
+		return nil, fmt.Errorf("unsupported custom_jwt_algorithm %q", algorithm)
+	}
+
+	switch k := key.(type) {
+	case []byte:
+		if !strings.HasPrefix(algorithm, "HS") {
+			return nil, fmt.Errorf("%s requires a PEM encoded private key", algorithm)
+		}
+		mac := hmac.New(hashFunc.New, k)
+		mac.Write(input)
+		return mac.Sum(nil), nil
+	case *rsa.PrivateKey:
+		if !strings.HasPrefix(algorithm, "RS") {
+			return nil, fmt.Errorf("%s can't be used with an RSA key", algorithm)
+		}
+		digest := hashFunc.New()
+		digest.Write(input)
+		return rsa.SignPKCS1v15(rand.Reader, k, hashFunc, digest.Sum(nil))
+	case *ecdsa.PrivateKey:
+		if !strings.HasPrefix(algorithm, "ES") {
+			return nil, fmt.Errorf("%s can't be used with an ECDSA key", algorithm)
+		}
+		digest := hashFunc.New()
+		digest.Write(input)
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest.Sum(nil))
+		if err != nil {
+			return nil, err
+		}
+		// JWS expects the fixed size concatenation of r and s
+		size := (k.Curve.Params().BitSize + 7) / 8
+		signature := make([]byte, 2*size)
+		r.FillBytes(signature[:size])
+		s.FillBytes(signature[size:])
+		return signature, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+func parsePrivateKey(block *pem.Block) (interface{}, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
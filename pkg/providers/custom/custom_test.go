@@ -0,0 +1,229 @@
+package custom
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSendHMAC(t *testing.T) {
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                        "github",
+		CustomWebhookURL:          server.URL,
+		CustomMethod:              http.MethodPost,
+		CustomHMACSecret:          "secret",
+		CustomHMACHeader:          "X-Hub-Signature-256",
+		CustomHMACTimestampHeader: "X-Timestamp",
+	}}, nil)
+	if err := provider.Send("hello", ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(header.Get("X-Timestamp") + "." + string(body)))
+	if expected := "sha256=" + hex.EncodeToString(mac.Sum(nil)); header.Get("X-Hub-Signature-256") != expected {
+		t.Errorf("expected signature %q, got %q", expected, header.Get("X-Hub-Signature-256"))
+	}
+
+	secret := []byte("standard-webhooks-secret")
+	provider, _ = New([]*Options{{
+		ID:               "standard",
+		CustomWebhookURL: server.URL,
+		CustomMethod:     http.MethodPost,
+		CustomHMACSecret: "whsec_" + base64.StdEncoding.EncodeToString(secret),
+		CustomHMACStyle:  "standard",
+	}}, nil)
+	if err := provider.Send("hello", ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+	mac = hmac.New(sha256.New, secret)
+	mac.Write([]byte(header.Get("webhook-id") + "." + header.Get("webhook-timestamp") + "." + string(body)))
+	if expected := "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil)); header.Get("webhook-signature") != expected {
+		t.Errorf("expected signature %q, got %q", expected, header.Get("webhook-signature"))
+	}
+}
+
+func TestSendJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalECPrivateKey(key)
+	keyFile := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                "jwt",
+		CustomWebhookURL:  server.URL,
+		CustomMethod:      http.MethodPost,
+		CustomJWTKeyFile:  keyFile,
+		CustomJWTIssuer:   "notify",
+		CustomJWTAudience: "receiver",
+	}}, nil)
+	if err := provider.Send("hello", ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(authorization, "Bearer "), ".")
+	if len(parts) != 3 {
+		t.Fatalf("unexpected authorization header %q", authorization)
+	}
+	var header, claims map[string]interface{}
+	decodeSegment(t, parts[0], &header)
+	decodeSegment(t, parts[1], &claims)
+	if header["alg"] != "ES256" || claims["iss"] != "notify" || claims["aud"] != "receiver" {
+		t.Errorf("unexpected token header %v and claims %v", header, claims)
+	}
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+	if !ecdsa.Verify(&key.PublicKey, digest[:], r, s) {
+		t.Error("invalid token signature")
+	}
+}
+
+func TestInvalidJWTAlgorithm(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(keyFile, []byte("secret"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	options := &Options{
+		ID:                 "jwt",
+		CustomWebhookURL:   "https://example.com/hook",
+		CustomJWTKeyFile:   keyFile,
+		CustomJWTAlgorithm: "X",
+	}
+	if err := options.Validate(); err == nil || !strings.Contains(err.Error(), "custom_jwt_algorithm") {
+		t.Errorf("expected custom_jwt_algorithm to be rejected, got %v", err)
+	}
+	if _, err := options.jwtToken(); err == nil || !strings.Contains(err.Error(), "unsupported custom_jwt_algorithm") {
+		t.Errorf("expected an unsupported algorithm error, got %v", err)
+	}
+}
+
+func TestSendUnexpectedStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:               "webhook",
+		CustomWebhookURL: server.URL,
+		CustomMethod:     http.MethodPost,
+	}}, nil)
+	if err := provider.Send("hello", ""); err == nil || !strings.Contains(err.Error(), "unexpected status code 401") {
+		t.Errorf("expected unexpected status error, got %v", err)
+	}
+
+	provider.Custom[0].CustomSuccessStatus = []string{"200-299", "401"}
+	if err := provider.Send("hello", ""); err != nil {
+		t.Errorf("expected 401 to be accepted, got %v", err)
+	}
+}
+
+func TestSendTemplatedRequest(t *testing.T) {
+	var request *http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		_, _ = w.Write([]byte(`{"ok":true,"result":{"ids":[42]}}`))
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:               "webhook",
+		CustomWebhookURL: server.URL + "/hosts/{{ .host }}?source=notify",
+		CustomMethod:     http.MethodPost,
+		CustomHeaders:    map[string]string{"X-Severity": "{{ .severity }}"},
+		CustomQuery:      map[string]string{"template": `{{ index . "template-id" }}`},
+		CustomUsername:   "user",
+		CustomPassword:   "pass",
+		CustomResponseAssertions: map[string]string{
+			"$.ok":               "true",
+			"$.result.ids[0]":    "42",
+			"$['result']['ids']": "",
+		},
+	}}, nil)
+	message := `{"host":"example.com","severity":"high","template-id":"exposed-panel"}`
+	if err := provider.Send(message, ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+	if request.URL.Path != "/hosts/example.com" || request.URL.Query().Get("source") != "notify" || request.URL.Query().Get("template") != "exposed-panel" {
+		t.Errorf("unexpected request url %s", request.URL)
+	}
+	if request.Header.Get("X-Severity") != "high" {
+		t.Errorf("unexpected severity header %q", request.Header.Get("X-Severity"))
+	}
+	if user, pass, ok := request.BasicAuth(); !ok || user != "user" || pass != "pass" {
+		t.Errorf("unexpected basic auth %q:%q", user, pass)
+	}
+
+	provider.Custom[0].CustomResponseAssertions = map[string]string{"$.ok": "false"}
+	if err := provider.Send(message, ""); err == nil || !strings.Contains(err.Error(), `expected "false", got "true"`) {
+		t.Errorf("expected assertion error, got %v", err)
+	}
+}
+
+func TestIsSuccessStatus(t *testing.T) {
+	tests := []struct {
+		specs    []string
+		status   int
+		expected bool
+	}{
+		{nil, 204, true},
+		{nil, 302, false},
+		{[]string{"2xx", "409"}, 409, true},
+		{[]string{"200-201"}, 202, false},
+		{[]string{"3XX"}, 301, true},
+	}
+	for _, test := range tests {
+		success, err := isSuccessStatus(test.specs, test.status)
+		if err != nil || success != test.expected {
+			t.Errorf("isSuccessStatus(%v, %d) = %v, %v, expected %v", test.specs, test.status, success, err, test.expected)
+		}
+	}
+	if _, err := isSuccessStatus([]string{"ok"}, 200); err == nil {
+		t.Error("expected an error for an invalid status")
+	}
+}
+
+func decodeSegment(t *testing.T, segment string, v interface{}) {
+	t.Helper()
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
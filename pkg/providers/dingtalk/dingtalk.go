@@ -0,0 +1,81 @@
+package dingtalk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	DingTalk []*Options `yaml:"dingtalk,omitempty"`
+	counter  int
+}
+
+type Options struct {
+	ID                 string   `yaml:"id,omitempty"`
+	DingTalkWebhookURL string   `yaml:"dingtalk_webhook_url,omitempty"`
+	DingTalkSecret     string   `yaml:"dingtalk_secret,omitempty"`
+	DingTalkKeyword    string   `yaml:"dingtalk_keyword,omitempty"`
+	DingTalkMsgType    string   `yaml:"dingtalk_msg_type,omitempty"`
+	DingTalkTitle      string   `yaml:"dingtalk_title,omitempty"`
+	DingTalkCardButton string   `yaml:"dingtalk_card_button,omitempty"`
+	DingTalkCardURL    string   `yaml:"dingtalk_card_url,omitempty"`
+	DingTalkAtMobiles  []string `yaml:"dingtalk_at_mobiles,omitempty"`
+	DingTalkAtUserIDs  []string `yaml:"dingtalk_at_user_ids,omitempty"`
+	DingTalkAtAll      bool     `yaml:"dingtalk_at_all,omitempty"`
+	DingTalkFormat     string   `yaml:"dingtalk_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			// the message type is validated regardless of case
+			o.DingTalkMsgType = strings.ToLower(o.DingTalkMsgType)
+			provider.DingTalk = append(provider.DingTalk, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var DingTalkErr error
+	p.counter++
+	for _, pr := range p.DingTalk {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.DingTalkFormat), p.counter)
+
+		payload, err := pr.buildRequest(msg, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build dingtalk message for id: %s ", pr.ID))
+			DingTalkErr = multierr.Append(DingTalkErr, err)
+			continue
+		}
+
+		if err := pr.SendMessage(payload); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send dingtalk notification for id: %s ", pr.ID))
+			DingTalkErr = multierr.Append(DingTalkErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("dingtalk notification sent for id: %s", pr.ID)
+	}
+	return DingTalkErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("dingtalk_webhook_url", options.DingTalkWebhookURL),
+		utils.ValidURL("dingtalk_webhook_url", options.DingTalkWebhookURL),
+		utils.OneOf("dingtalk_msg_type", options.DingTalkMsgType, "text", "markdown", "actionCard"),
+	)
+}
@@ -0,0 +1,126 @@
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// security setting error codes returned by the robot webhook
+const (
+	errCodeKeyword   = 310000
+	errCodeSignature = 310001
+	errCodeIP        = 310002
+)
+
+// sign returns the timestamp and signature query parameters for the robot secret
+func sign(secret string, now time.Time) (string, string) {
+	timestamp := strconv.FormatInt(now.UnixMilli(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	return timestamp, base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (options *Options) webhookURL() (string, error) {
+	if options.DingTalkSecret == "" {
+		return options.DingTalkWebhookURL, nil
+	}
+	webhookURL, err := url.Parse(options.DingTalkWebhookURL)
+	if err != nil {
+		return "", err
+	}
+	timestamp, signature := sign(options.DingTalkSecret, time.Now())
+	query := webhookURL.Query()
+	query.Set("timestamp", timestamp)
+	query.Set("sign", signature)
+	webhookURL.RawQuery = query.Encode()
+	return webhookURL.String(), nil
+}
+
+// buildRequest renders the message into the configured message type
+func (options *Options) buildRequest(message, rawMessage string, counter int) (*APIRequest, error) {
+	if options.DingTalkKeyword != "" && !strings.Contains(message, options.DingTalkKeyword) {
+		message = options.DingTalkKeyword + " " + message
+	}
+
+	title, err := utils.RenderTemplate(options.DingTalkTitle, rawMessage, counter)
+	if err != nil {
+		return nil, err
+	}
+	if title = strings.TrimSpace(title); title == "" {
+		title = "notify"
+	}
+
+	payload := &APIRequest{MsgType: options.DingTalkMsgType}
+	at := &At{
+		AtMobiles: options.DingTalkAtMobiles,
+		AtUserIds: options.DingTalkAtUserIDs,
+		IsAtAll:   options.DingTalkAtAll,
+	}
+
+	switch options.DingTalkMsgType {
+	case "", "text":
+		payload.MsgType = "text"
+		payload.Text = &Text{Content: message}
+		payload.At = at
+	case "markdown":
+		// markdown messages only notify users who are also mentioned in the text
+		for _, mention := range append(append([]string{}, options.DingTalkAtMobiles...), options.DingTalkAtUserIDs...) {
+			message += " @" + mention
+		}
+		payload.Markdown = &Markdown{Title: title, Text: message}
+		payload.At = at
+	case "actioncard":
+		payload.MsgType = "actionCard"
+		payload.ActionCard = &ActionCard{
+			Title:       title,
+			Text:        message,
+			SingleTitle: options.DingTalkCardButton,
+			SingleURL:   options.DingTalkCardURL,
+		}
+		if payload.ActionCard.SingleURL != "" && payload.ActionCard.SingleTitle == "" {
+			payload.ActionCard.SingleTitle = "View"
+		}
+	default:
+		return nil, fmt.Errorf("invalid dingtalk message type %q", options.DingTalkMsgType)
+	}
+	return payload, nil
+}
+
+func (options *Options) SendMessage(payload *APIRequest) error {
+	webhookURL, err := options.webhookURL()
+	if err != nil {
+		return err
+	}
+
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(webhookURL, payload, headers, &response); err != nil {
+		return err
+	}
+
+	switch response.ErrCode {
+	case 0:
+		return nil
+	case errCodeKeyword:
+		return fmt.Errorf("message rejected by the robot keyword security setting, check dingtalk_keyword: %s", response.ErrMsg)
+	case errCodeSignature:
+		return fmt.Errorf("message rejected by the robot signature security setting, check dingtalk_secret: %s", response.ErrMsg)
+	case errCodeIP:
+		return fmt.Errorf("message rejected by the robot IP allowlist security setting: %s", response.ErrMsg)
+	default:
+		return fmt.Errorf("error while sending dingtalk message: %d %s", response.ErrCode, response.ErrMsg)
+	}
+}
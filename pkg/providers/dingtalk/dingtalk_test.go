@@ -0,0 +1,47 @@
+package dingtalk
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	timestamp, signature := sign("SECxxxx", time.Unix(1700000000, 0))
+	if timestamp != "1700000000000" {
+		t.Errorf("expected millisecond timestamp, got %s", timestamp)
+	}
+	if signature != "LReYwot3QanuU+gyLj4pdQ1iIDJFDFXY6qpWq1daJt8=" {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
+
+func TestWebhookURL(t *testing.T) {
+	options := &Options{
+		DingTalkWebhookURL: "https://oapi.dingtalk.com/robot/send?access_token=token",
+		DingTalkSecret:     "SECxxxx",
+	}
+	webhookURL, err := options.webhookURL()
+	if err != nil {
+		t.Fatalf("could not build webhook url: %s", err)
+	}
+	parsed, err := url.Parse(webhookURL)
+	if err != nil {
+		t.Fatalf("could not parse webhook url: %s", err)
+	}
+	query := parsed.Query()
+	if query.Get("access_token") != "token" || query.Get("timestamp") == "" || query.Get("sign") == "" {
+		t.Errorf("expected access token and signature parameters, got %s", webhookURL)
+	}
+}
+
+func TestMsgTypeCase(t *testing.T) {
+	provider, _ := New([]*Options{{ID: "dingtalk", DingTalkMsgType: "ActionCard"}}, nil)
+	payload, err := provider.DingTalk[0].buildRequest("message", "message", 1)
+	if err != nil {
+		t.Fatalf("could not build request: %s", err)
+	}
+	if payload.MsgType != "actionCard" || payload.ActionCard == nil {
+		t.Errorf("expected an actionCard message, got %q", payload.MsgType)
+	}
+}
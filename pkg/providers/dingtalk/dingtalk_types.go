@@ -0,0 +1,36 @@
+package dingtalk
+
+type APIRequest struct {
+	MsgType    string      `json:"msgtype"`
+	Text       *Text       `json:"text,omitempty"`
+	Markdown   *Markdown   `json:"markdown,omitempty"`
+	ActionCard *ActionCard `json:"actionCard,omitempty"`
+	At         *At         `json:"at,omitempty"`
+}
+
+type Text struct {
+	Content string `json:"content"`
+}
+
+type Markdown struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type ActionCard struct {
+	Title       string `json:"title"`
+	Text        string `json:"text"`
+	SingleTitle string `json:"singleTitle,omitempty"`
+	SingleURL   string `json:"singleURL,omitempty"`
+}
+
+type At struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	AtUserIds []string `json:"atUserIds,omitempty"`
+	IsAtAll   bool     `json:"isAtAll,omitempty"`
+}
+
+type APIResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg,omitempty"`
+}
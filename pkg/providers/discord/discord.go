@@ -10,6 +10,7 @@ import (
 
 	"github.com/projectdiscovery/gologger"
 	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
 	sliceutil "github.com/projectdiscovery/utils/slice"
 )
 
@@ -84,4 +85,30 @@ func (p *Provider) Send(message, CliFormat string) error {
 		gologger.Verbose().Msgf("discord notification sent for id: %s", pr.ID)
 	}
 	return multierr.Combine(errs...)
-}
\ No newline at end of file
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	if err := utils.Required("discord_webhook_url", options.DiscordWebHookURL); err != nil {
+		return err
+	}
+	var err error
+	if options.DiscordThreads {
+		err = utils.Required("discord_thread_id", options.DiscordThreadID)
+	}
+	if _, urlErr := reDiscordWebhook.Groups(options.DiscordWebHookURL); urlErr != nil {
+		err = multierr.Append(err, utils.NewFieldError("discord_webhook_url", "expected a https://discord.com/api/webhooks/<id>/<token> url"))
+	}
+	return err
+}
+
+// HealthCheck fetches the webhook, which discord allows without posting a message
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Discord {
+		results = append(results, utils.CheckHealth(pr.ID, false, func() error {
+			return httpreq.NewClient().Ping(pr.DiscordWebHookURL, nil)
+		}))
+	}
+	return results
+}
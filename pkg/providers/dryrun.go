@@ -0,0 +1,139 @@
+package providers
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// DryRunner is implemented by providers delivering notifications without
+// http, which write what each id would receive instead of sending it.
+// Providers using http are dry run by the transport of the default client.
+type DryRunner interface {
+	DryRun(message, CliFormat string, w io.Writer) error
+}
+
+var (
+	reSecretOption     = regexp.MustCompile(`(^|_)(token|password|secret|api_key|user_key|routing_key|pat)$`)
+	reSecretQueryParam = regexp.MustCompile(`(?i)(token|key|secret|signature|sig|password)`)
+)
+
+// DryRunWriter returns the writer the payloads are written to in dry run mode
+func (p *Client) DryRunWriter() io.Writer {
+	return p.dryRun
+}
+
+// dryRunSend writes the payloads of the provider instead of sending them
+func (p *Client) dryRunSend(provider Provider, message string) error {
+	name, _ := optionsField(provider)
+	if _, err := fmt.Fprintf(p.dryRun, "[%s]\n", name); err != nil {
+		return err
+	}
+	if dryRunner, ok := provider.(DryRunner); ok {
+		return dryRunner.DryRun(message, p.options.MessageFormat, p.dryRun)
+	}
+	return provider.Send(message, p.options.MessageFormat)
+}
+
+// Secrets returns the credentials of the provider config: the values of the
+// token, password and key options and the credentials embedded in urls
+func (p *ProviderOptions) Secrets() []string {
+	var secrets []string
+	providers := reflect.ValueOf(p).Elem()
+	for i := 0; i < providers.NumField(); i++ {
+		list := providers.Field(i)
+		if list.Kind() != reflect.Slice {
+			continue
+		}
+		for j := 0; j < list.Len(); j++ {
+			options := reflect.Indirect(list.Index(j))
+			if options.Kind() == reflect.Struct {
+				secrets = append(secrets, optionSecrets(options)...)
+			}
+		}
+	}
+	return secrets
+}
+
+func optionSecrets(options reflect.Value) []string {
+	var secrets []string
+	for name, field := range yamlFields(options.Type()) {
+		value := options.FieldByIndex(field.Index)
+		switch {
+		case value.Kind() == reflect.String && reSecretOption.MatchString(name):
+			secrets = append(secrets, value.String())
+		case value.Kind() == reflect.String && name == "url":
+			secrets = append(secrets, serviceURLSecrets(value.String())...)
+		case value.Kind() == reflect.String && strings.HasSuffix(name, "_url"):
+			secrets = append(secrets, urlSecrets(value.String(), strings.HasSuffix(name, "webhook_url"))...)
+		case value.Kind() == reflect.Map && value.Type().Elem().Kind() == reflect.String:
+			// custom headers such as Authorization or X-Api-Key
+			iter := value.MapRange()
+			for iter.Next() {
+				if reSecretQueryParam.MatchString(iter.Key().String()) || strings.EqualFold(iter.Key().String(), "authorization") {
+					credential := iter.Value().String()
+					if _, token, ok := strings.Cut(credential, " "); ok {
+						credential = token
+					}
+					secrets = append(secrets, credential)
+				}
+			}
+		}
+	}
+	return secrets
+}
+
+// urlSecrets returns the password, the credential query values and, for
+// webhook urls, the last path segment that usually holds the webhook token
+func urlSecrets(value string, webhook bool) []string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil
+	}
+	var secrets []string
+	if password, ok := u.User.Password(); ok {
+		secrets = append(secrets, password, url.QueryEscape(password))
+	}
+	for key, values := range u.Query() {
+		if webhook || reSecretQueryParam.MatchString(key) {
+			secrets = append(secrets, values...)
+		}
+	}
+	if webhook {
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if last := segments[len(segments)-1]; len(last) >= 16 {
+			secrets = append(secrets, last)
+		}
+	}
+	return secrets
+}
+
+// serviceURLSecrets returns the credentials of a shoutrrr service url, which
+// services put in the userinfo, the host, the path or the query
+func serviceURLSecrets(value string) []string {
+	u, err := url.Parse(value)
+	if err != nil {
+		return nil
+	}
+	var secrets []string
+	if password, ok := u.User.Password(); ok {
+		secrets = append(secrets, password, url.QueryEscape(password))
+	} else if username := u.User.Username(); username != "" {
+		// discord and telegram tokens have no user name
+		secrets = append(secrets, username, url.QueryEscape(username))
+	}
+	for _, part := range append(strings.Split(u.Path, "/"), u.Hostname()) {
+		if len(part) >= 16 && !strings.Contains(part, ".") {
+			secrets = append(secrets, part)
+		}
+	}
+	for key, values := range u.Query() {
+		if reSecretQueryParam.MatchString(key) {
+			secrets = append(secrets, values...)
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,136 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/utils/batcher"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultIndex         = "notify"
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+)
+
+type Provider struct {
+	Elasticsearch []*Options `yaml:"elasticsearch,omitempty"`
+	counter       int
+}
+
+type Options struct {
+	ID                         string        `yaml:"id,omitempty"`
+	ElasticsearchURL           string        `yaml:"elasticsearch_url,omitempty"`
+	ElasticsearchIndex         string        `yaml:"elasticsearch_index,omitempty"`
+	ElasticsearchUsername      string        `yaml:"elasticsearch_username,omitempty"`
+	ElasticsearchPassword      string        `yaml:"elasticsearch_password,omitempty"`
+	ElasticsearchAPIKey        string        `yaml:"elasticsearch_api_key,omitempty"`
+	ElasticsearchBatchSize     int           `yaml:"elasticsearch_batch_size,omitempty"`
+	ElasticsearchFlushInterval time.Duration `yaml:"elasticsearch_flush_interval,omitempty"`
+	ElasticsearchFormat        string        `yaml:"elasticsearch_format,omitempty"`
+
+	batcher *batcher.Batcher[*document]
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.ElasticsearchIndex == "" {
+				o.ElasticsearchIndex = defaultIndex
+			}
+			if o.ElasticsearchBatchSize <= 0 {
+				o.ElasticsearchBatchSize = defaultBatchSize
+			}
+			if o.ElasticsearchFlushInterval <= 0 {
+				o.ElasticsearchFlushInterval = defaultFlushInterval
+			}
+			o.batcher = batcher.New[*document](
+				batcher.WithMaxCapacity[*document](o.ElasticsearchBatchSize),
+				batcher.WithFlushInterval[*document](o.ElasticsearchFlushInterval),
+				batcher.WithFlushCallback[*document](o.flush),
+			)
+			o.batcher.Run()
+			provider.Elasticsearch = append(provider.Elasticsearch, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var ElasticsearchErr error
+	p.counter++
+	for _, pr := range p.Elasticsearch {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ElasticsearchFormat), p.counter)
+
+		if pr.ElasticsearchURL == "" {
+			err := errors.Wrap(fmt.Errorf("elasticsearch_url value is required"),
+				fmt.Sprintf("failed to send elasticsearch notification for id: %s ", pr.ID))
+			ElasticsearchErr = multierr.Append(ElasticsearchErr, err)
+			continue
+		}
+
+		// date math expressions such as <notify-{now/d}> are resolved by elasticsearch
+		index, err := utils.RenderTemplate(pr.ElasticsearchIndex, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render elasticsearch index for id: %s ", pr.ID))
+			ElasticsearchErr = multierr.Append(ElasticsearchErr, err)
+			continue
+		}
+
+		source, err := buildDocument(msg, time.Now())
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build elasticsearch document for id: %s ", pr.ID))
+			ElasticsearchErr = multierr.Append(ElasticsearchErr, err)
+			continue
+		}
+		pr.batcher.Append(&document{index: strings.TrimSpace(index), source: source})
+	}
+	return ElasticsearchErr
+}
+
+// flush delivers a batch of buffered documents
+func (options *Options) flush(documents []*document) {
+	if err := options.SendDocuments(documents); err != nil {
+		gologger.Error().Msgf("failed to send elasticsearch notification for id: %s: %s", options.ID, err)
+		return
+	}
+	gologger.Verbose().Msgf("elasticsearch notification sent for id: %s (%d documents)", options.ID, len(documents))
+}
+
+// Close flushes the buffered documents
+func (p *Provider) Close() error {
+	for _, pr := range p.Elasticsearch {
+		pr.batcher.Stop()
+		pr.batcher.WaitDone()
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("elasticsearch_url", options.ElasticsearchURL),
+		utils.ValidURL("elasticsearch_url", options.ElasticsearchURL),
+	)
+}
+
+// HealthCheck queries the cluster root endpoint with the configured credentials
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Elasticsearch {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
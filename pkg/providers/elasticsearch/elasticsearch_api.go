@@ -0,0 +1,96 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// buildDocument returns the source of the document, keeping JSON messages structured
+func buildDocument(message string, ts time.Time) ([]byte, error) {
+	source := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(message), &source); err != nil {
+		source = map[string]interface{}{"message": message}
+	}
+	if _, ok := source["@timestamp"]; !ok {
+		source["@timestamp"] = ts.UTC().Format(time.RFC3339Nano)
+	}
+	return json.Marshal(source)
+}
+
+func (options *Options) authorization() string {
+	switch {
+	case options.ElasticsearchAPIKey != "":
+		return fmt.Sprintf("ApiKey %s", options.ElasticsearchAPIKey)
+	case options.ElasticsearchUsername != "":
+		credentials := base64.StdEncoding.EncodeToString([]byte(options.ElasticsearchUsername + ":" + options.ElasticsearchPassword))
+		return fmt.Sprintf("Basic %s", credentials)
+	default:
+		return ""
+	}
+}
+
+// SendDocuments indexes a batch of documents with the bulk API
+func (options *Options) SendDocuments(documents []*document) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, document := range documents {
+		if err := encoder.Encode(BulkAction{Index: &BulkIndex{Index: document.index}}); err != nil {
+			return err
+		}
+		body.Write(document.source)
+		body.WriteByte('\n')
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(options.ElasticsearchURL, "/")+"/_bulk", &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if authorization := options.authorization(); authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	res, err := httpreq.NewClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response BulkResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error trying to unmarshal the response: %d %v", res.StatusCode, err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("error while indexing elasticsearch documents: %d %v", res.StatusCode, response.Error)
+	}
+	if response.Errors {
+		var failed int
+		var reason string
+		for _, item := range response.Items {
+			for _, result := range item {
+				if result.Error != nil {
+					failed++
+					reason = fmt.Sprintf("%s: %s", result.Error.Type, result.Error.Reason)
+				}
+			}
+		}
+		return fmt.Errorf("error while indexing %d of %d elasticsearch documents: %s", failed, len(documents), reason)
+	}
+	return nil
+}
+
+// CheckConnection queries the cluster root endpoint with the configured credentials
+func (options *Options) CheckConnection() error {
+	headers := http.Header{}
+	if authorization := options.authorization(); authorization != "" {
+		headers.Set("Authorization", authorization)
+	}
+	return httpreq.NewClient().Ping(options.ElasticsearchURL, headers)
+}
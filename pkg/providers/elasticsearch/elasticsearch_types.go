@@ -0,0 +1,27 @@
+package elasticsearch
+
+type BulkAction struct {
+	Index *BulkIndex `json:"index"`
+}
+
+type BulkIndex struct {
+	Index string `json:"_index"`
+}
+
+type BulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+		Error  *struct {
+			Type   string `json:"type"`
+			Reason string `json:"reason"`
+		} `json:"error,omitempty"`
+	} `json:"items,omitempty"`
+	Error interface{} `json:"error,omitempty"`
+}
+
+// document is a rendered bulk index operation
+type document struct {
+	index  string
+	source []byte
+}
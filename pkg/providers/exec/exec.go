@@ -0,0 +1,156 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	osexec "os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultTimeout = 30 * time.Second
+
+	// maxOutputLength is the maximum command output included in errors
+	maxOutputLength = 512
+)
+
+type Provider struct {
+	Exec    []*Options `yaml:"exec,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID          string            `yaml:"id,omitempty"`
+	ExecCommand string            `yaml:"exec_command,omitempty"`
+	ExecTimeout time.Duration     `yaml:"exec_timeout,omitempty"`
+	ExecEnv     map[string]string `yaml:"exec_env,omitempty"`
+	ExecFormat  string            `yaml:"exec_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.ExecTimeout <= 0 {
+				o.ExecTimeout = defaultTimeout
+			}
+			provider.Exec = append(provider.Exec, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var ExecErr error
+	p.counter++
+	for _, pr := range p.Exec {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ExecFormat), p.counter)
+
+		if err := pr.run(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send exec notification for id: %s ", pr.ID))
+			ExecErr = multierr.Append(ExecErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("exec notification sent for id: %s", pr.ID)
+	}
+	return ExecErr
+}
+
+// DryRun writes the commands that would be run along with their input
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var ExecErr error
+	p.counter++
+	for _, pr := range p.Exec {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ExecFormat), p.counter)
+
+		args, env, err := pr.command(message, p.counter)
+		if err == nil {
+			payload := fmt.Sprintf("EXEC %s\n", strings.Join(args, " "))
+			for _, variable := range env {
+				payload += fmt.Sprintf("ENV %s\n", variable)
+			}
+			err = utils.WriteDryRun(w, pr.ID, payload+"\n"+msg)
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run exec notification for id: %s ", pr.ID))
+			ExecErr = multierr.Append(ExecErr, err)
+		}
+	}
+	return ExecErr
+}
+
+// command returns the arguments of the command and the rendered variables
+// added to its environment
+func (options *Options) command(rawMessage string, counter int) ([]string, []string, error) {
+	args, err := shlex.Split(options.ExecCommand)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(args) == 0 {
+		return nil, nil, fmt.Errorf("exec_command value is required")
+	}
+
+	var env []string
+	for name, value := range options.ExecEnv {
+		rendered, err := utils.RenderTemplate(value, rawMessage, counter)
+		if err != nil {
+			return nil, nil, err
+		}
+		env = append(env, name+"="+rendered)
+	}
+	sort.Strings(env)
+	return args, env, nil
+}
+
+// run pipes the message to the command stdin
+func (options *Options) run(msg, rawMessage string, counter int) error {
+	args, extraEnv, err := options.command(rawMessage, counter)
+	if err != nil {
+		return err
+	}
+	env := append(os.Environ(), extraEnv...)
+
+	ctx, cancel := context.WithTimeout(context.Background(), options.ExecTimeout)
+	defer cancel()
+
+	var output bytes.Buffer
+	cmd := osexec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = env
+	cmd.Stdin = strings.NewReader(msg + "\n")
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("command timed out after %s", options.ExecTimeout)
+		}
+		out := strings.TrimSpace(output.String())
+		if len(out) > maxOutputLength {
+			out = out[:maxOutputLength] + "..."
+		}
+		return fmt.Errorf("%s: %s", err, out)
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return utils.Required("exec_command", options.ExecCommand)
+}
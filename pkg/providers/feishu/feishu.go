@@ -0,0 +1,79 @@
+package feishu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	Feishu  []*Options `yaml:"feishu,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID               string   `yaml:"id,omitempty"`
+	FeishuWebhookURL string   `yaml:"feishu_webhook_url,omitempty"`
+	FeishuSecret     string   `yaml:"feishu_secret,omitempty"`
+	FeishuKeyword    string   `yaml:"feishu_keyword,omitempty"`
+	FeishuMsgType    string   `yaml:"feishu_msg_type,omitempty"`
+	FeishuTitle      string   `yaml:"feishu_title,omitempty"`
+	FeishuCardColor  string   `yaml:"feishu_card_color,omitempty"`
+	FeishuAtUserIDs  []string `yaml:"feishu_at_user_ids,omitempty"`
+	FeishuAtAll      bool     `yaml:"feishu_at_all,omitempty"`
+	FeishuFormat     string   `yaml:"feishu_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			// the message type is validated regardless of case
+			o.FeishuMsgType = strings.ToLower(o.FeishuMsgType)
+			provider.Feishu = append(provider.Feishu, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var FeishuErr error
+	p.counter++
+	for _, pr := range p.Feishu {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.FeishuFormat), p.counter)
+
+		payload, err := pr.buildRequest(msg, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build feishu message for id: %s ", pr.ID))
+			FeishuErr = multierr.Append(FeishuErr, err)
+			continue
+		}
+
+		if err := pr.SendMessage(payload); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send feishu notification for id: %s ", pr.ID))
+			FeishuErr = multierr.Append(FeishuErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("feishu notification sent for id: %s", pr.ID)
+	}
+	return FeishuErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("feishu_webhook_url", options.FeishuWebhookURL),
+		utils.ValidURL("feishu_webhook_url", options.FeishuWebhookURL),
+		utils.OneOf("feishu_msg_type", options.FeishuMsgType, "text", "card"),
+	)
+}
@@ -0,0 +1,114 @@
+package feishu
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// security setting error codes returned by the bot webhook
+const (
+	errCodeSignature = 19021
+	errCodeIP        = 19022
+	errCodeKeyword   = 19024
+)
+
+// sign returns the timestamp and signature fields for the bot secret
+func sign(secret string, now time.Time) (string, string) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return timestamp, base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// mentions returns the at tags for the configured users in the given message type
+func (options *Options) mentions(msgType string) string {
+	users := options.FeishuAtUserIDs
+	if options.FeishuAtAll {
+		users = append(append([]string{}, users...), "all")
+	}
+
+	var tags []string
+	for _, user := range users {
+		if msgType == "text" {
+			tags = append(tags, fmt.Sprintf(`<at user_id="%s"></at>`, user))
+		} else {
+			tags = append(tags, fmt.Sprintf(`<at id=%s></at>`, user))
+		}
+	}
+	return strings.Join(tags, " ")
+}
+
+// buildRequest renders the message into the configured message type
+func (options *Options) buildRequest(message, rawMessage string, counter int) (*APIRequest, error) {
+	if options.FeishuKeyword != "" && !strings.Contains(message, options.FeishuKeyword) {
+		message = options.FeishuKeyword + " " + message
+	}
+
+	payload := &APIRequest{MsgType: options.FeishuMsgType}
+	switch options.FeishuMsgType {
+	case "", "text":
+		payload.MsgType = "text"
+		if mentions := options.mentions("text"); mentions != "" {
+			message = mentions + " " + message
+		}
+		payload.Content = &Content{Text: message}
+	case "card":
+		title, err := utils.RenderTemplate(options.FeishuTitle, rawMessage, counter)
+		if err != nil {
+			return nil, err
+		}
+		if title = strings.TrimSpace(title); title == "" {
+			title = "notify"
+		}
+		if mentions := options.mentions("card"); mentions != "" {
+			message = mentions + "\n" + message
+		}
+		payload.MsgType = "interactive"
+		payload.Card = &Card{
+			Header: &CardHeader{
+				Title:    &CardText{Tag: "plain_text", Content: title},
+				Template: options.FeishuCardColor,
+			},
+			Elements: []*CardElement{{Tag: "markdown", Content: message}},
+		}
+	default:
+		return nil, fmt.Errorf("invalid feishu message type %q", options.FeishuMsgType)
+	}
+
+	if options.FeishuSecret != "" {
+		payload.Timestamp, payload.Sign = sign(options.FeishuSecret, time.Now())
+	}
+	return payload, nil
+}
+
+func (options *Options) SendMessage(payload *APIRequest) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(options.FeishuWebhookURL, payload, headers, &response); err != nil {
+		return err
+	}
+
+	switch response.Code {
+	case 0:
+		return nil
+	case errCodeKeyword:
+		return fmt.Errorf("message rejected by the bot keyword security setting, check feishu_keyword: %s", response.Msg)
+	case errCodeSignature:
+		return fmt.Errorf("message rejected by the bot signature security setting, check feishu_secret: %s", response.Msg)
+	case errCodeIP:
+		return fmt.Errorf("message rejected by the bot IP allowlist security setting: %s", response.Msg)
+	default:
+		return fmt.Errorf("error while sending feishu message: %d %s", response.Code, response.Msg)
+	}
+}
@@ -0,0 +1,27 @@
+package feishu
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	timestamp, signature := sign("SECxxxx", time.Unix(1700000000, 0))
+	if timestamp != "1700000000" {
+		t.Errorf("expected second timestamp, got %s", timestamp)
+	}
+	if signature != "lQ3wM7O6cGDIaRnBHx80oIUA9J0dlIJrzR7Upte8BF8=" {
+		t.Errorf("unexpected signature %s", signature)
+	}
+}
+
+func TestMsgTypeCase(t *testing.T) {
+	provider, _ := New([]*Options{{ID: "feishu", FeishuMsgType: "Card"}}, nil)
+	payload, err := provider.Feishu[0].buildRequest("message", "message", 1)
+	if err != nil {
+		t.Fatalf("could not build request: %s", err)
+	}
+	if payload.MsgType != "interactive" {
+		t.Errorf("expected an interactive card message, got %q", payload.MsgType)
+	}
+}
@@ -0,0 +1,38 @@
+package feishu
+
+type APIRequest struct {
+	Timestamp string      `json:"timestamp,omitempty"`
+	Sign      string      `json:"sign,omitempty"`
+	MsgType   string      `json:"msg_type"`
+	Content   *Content    `json:"content,omitempty"`
+	Card      interface{} `json:"card,omitempty"`
+}
+
+type Content struct {
+	Text string `json:"text"`
+}
+
+type Card struct {
+	Header   *CardHeader    `json:"header,omitempty"`
+	Elements []*CardElement `json:"elements"`
+}
+
+type CardHeader struct {
+	Title    *CardText `json:"title"`
+	Template string    `json:"template,omitempty"`
+}
+
+type CardText struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+type CardElement struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+type APIResponse struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg,omitempty"`
+}
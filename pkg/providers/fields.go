@@ -0,0 +1,73 @@
+package providers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// OptionField is an option of a provider that can be entered as text
+type OptionField struct {
+	Name string
+	// Kind is string, bool, integer or list
+	Kind string
+}
+
+// ProviderNames returns the providers of the provider config in declaration order
+func ProviderNames() []string {
+	var names []string
+	t := reflect.TypeOf(ProviderOptions{})
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := providerName(t.Field(i)); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// ProviderFields returns the options of the provider taking a single value
+// or a list of values, in declaration order. Nested options such as custom
+// headers are left out.
+func ProviderFields(provider string) ([]OptionField, error) {
+	t := reflect.TypeOf(ProviderOptions{})
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := providerName(t.Field(i)); ok && name == provider {
+			return optionFields(t.Field(i).Type.Elem().Elem()), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown provider %q, expected one of %s", provider, strings.Join(ProviderNames(), ", "))
+}
+
+func providerName(field reflect.StructField) (string, bool) {
+	if field.Type.Kind() != reflect.Slice || field.Type.Elem().Kind() != reflect.Pointer {
+		return "", false
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	return name, true
+}
+
+func optionFields(t reflect.Type) []OptionField {
+	var fields []OptionField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if !field.IsExported() || name == "" || name == "-" {
+			continue
+		}
+		kind := ""
+		switch {
+		case field.Type == durationType || field.Type.Kind() == reflect.String:
+			kind = "string"
+		case field.Type.Kind() == reflect.Bool:
+			kind = "bool"
+		case field.Type.Kind() >= reflect.Int && field.Type.Kind() <= reflect.Uint64:
+			kind = "integer"
+		case field.Type.Kind() == reflect.Slice && field.Type.Elem().Kind() == reflect.String:
+			kind = "list"
+		default:
+			continue
+		}
+		fields = append(fields, OptionField{Name: name, Kind: kind})
+	}
+	return fields
+}
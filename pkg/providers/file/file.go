@@ -0,0 +1,117 @@
+package file
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	File    []*Options `yaml:"file,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                 string        `yaml:"id,omitempty"`
+	FilePath           string        `yaml:"file_path,omitempty"`
+	FileMaxSizeMB      int           `yaml:"file_max_size_mb,omitempty"`
+	FileRotateInterval time.Duration `yaml:"file_rotate_interval,omitempty"`
+	FileMaxBackups     int           `yaml:"file_max_backups,omitempty"`
+	FileCompress       bool          `yaml:"file_compress,omitempty"`
+	FileFormat         string        `yaml:"file_format,omitempty"`
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.File = append(provider.File, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var FileErr error
+	p.counter++
+	for _, pr := range p.File {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.FileFormat), p.counter)
+
+		if pr.FilePath == "" {
+			err := errors.Wrap(fmt.Errorf("file_path value is required"),
+				fmt.Sprintf("failed to send file notification for id: %s ", pr.ID))
+			FileErr = multierr.Append(FileErr, err)
+			continue
+		}
+
+		if err := pr.write(msg + "\n"); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send file notification for id: %s ", pr.ID))
+			FileErr = multierr.Append(FileErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("file notification sent for id: %s", pr.ID)
+	}
+	return FileErr
+}
+
+// DryRun writes the lines that would be appended to the files
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	p.counter++
+	for _, pr := range p.File {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.FileFormat), p.counter)
+		if err := utils.WriteDryRun(w, pr.ID, fmt.Sprintf("APPEND %s\n%s", pr.FilePath, msg)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write appends the line to the file, rotating it first when needed
+func (options *Options) write(line string) error {
+	if options.file == nil {
+		if err := options.open(); err != nil {
+			return err
+		}
+	}
+	if options.shouldRotate(len(line)) {
+		if err := options.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := options.file.WriteString(line)
+	options.size += int64(n)
+	return err
+}
+
+// Close closes the open files
+func (p *Provider) Close() error {
+	var FileErr error
+	for _, pr := range p.File {
+		if pr.file != nil {
+			FileErr = multierr.Append(FileErr, pr.file.Close())
+			pr.file = nil
+		}
+	}
+	return FileErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return utils.Required("file_path", options.FilePath)
+}
@@ -0,0 +1,134 @@
+package file
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const backupTimeFormat = "20060102T150405"
+
+// reBackupSuffix matches the suffixes added to the backups by rotate
+var reBackupSuffix = regexp.MustCompile(`^\.\d{8}T\d{6}(\.\d+)?(\.gz)?$`)
+
+// open opens the log file in append mode, creating its directory if needed
+func (options *Options) open() error {
+	if err := os.MkdirAll(filepath.Dir(options.FilePath), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(options.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	options.file = f
+	options.size = info.Size()
+	options.openedAt = time.Now()
+	return nil
+}
+
+// shouldRotate reports whether writing n more bytes requires a rotation
+func (options *Options) shouldRotate(n int) bool {
+	if options.size == 0 {
+		return false
+	}
+	if options.FileMaxSizeMB > 0 && options.size+int64(n) > int64(options.FileMaxSizeMB)*1024*1024 {
+		return true
+	}
+	return options.FileRotateInterval > 0 && time.Since(options.openedAt) >= options.FileRotateInterval
+}
+
+// rotate moves the current file to a timestamped backup and opens a new one
+func (options *Options) rotate() error {
+	if err := options.file.Close(); err != nil {
+		return err
+	}
+	options.file = nil
+
+	backup := fmt.Sprintf("%s.%s", options.FilePath, time.Now().Format(backupTimeFormat))
+	for i := 1; exists(backup) || exists(backup+".gz"); i++ {
+		backup = fmt.Sprintf("%s.%s.%d", options.FilePath, time.Now().Format(backupTimeFormat), i)
+	}
+	if err := os.Rename(options.FilePath, backup); err != nil {
+		return err
+	}
+	if options.FileCompress {
+		if err := compress(backup); err != nil {
+			return err
+		}
+	}
+	if err := options.prune(); err != nil {
+		return err
+	}
+	return options.open()
+}
+
+// prune removes the oldest backups beyond the configured maximum
+func (options *Options) prune() error {
+	if options.FileMaxBackups <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(filepath.Dir(options.FilePath))
+	if err != nil {
+		return err
+	}
+	base := filepath.Base(options.FilePath)
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, base) && reBackupSuffix.MatchString(name[len(base):]) {
+			backups = append(backups, filepath.Join(filepath.Dir(options.FilePath), name))
+		}
+	}
+	// timestamped names sort chronologically
+	sort.Strings(backups)
+	for len(backups) > options.FileMaxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// compress gzips the given file and removes the original
+func compress(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(dst)
+	if _, err := io.Copy(gz, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,52 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRotateBySize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "notify.log")
+	// files sharing the prefix of the backups are left alone
+	for _, name := range []string{"notify.log.bak", "notify.log.lock"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	provider, _ := New([]*Options{{
+		ID:             "file",
+		FilePath:       path,
+		FileMaxSizeMB:  1,
+		FileMaxBackups: 1,
+		FileCompress:   true,
+	}}, nil)
+	defer provider.Close()
+
+	line := strings.Repeat("a", 600*1024)
+	for i := 0; i < 3; i++ {
+		if err := provider.Send(line, ""); err != nil {
+			t.Fatalf("could not send message: %s", err)
+		}
+	}
+
+	backups, _ := filepath.Glob(path + ".*.gz")
+	if len(backups) != 1 {
+		t.Errorf("expected a single compressed backup, got %v", backups)
+	}
+	for _, name := range []string{"notify.log.bak", "notify.log.lock"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s to survive the rotation: %s", name, err)
+		}
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("could not stat current file: %s", err)
+	}
+	if info.Size() != int64(len(line)+1) {
+		t.Errorf("expected current file to contain only the last message, got %d bytes", info.Size())
+	}
+}
@@ -0,0 +1,139 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultBaseURL = "https://api.github.com"
+
+type Provider struct {
+	Github  []*Options `yaml:"github_issues,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                string   `yaml:"id,omitempty"`
+	GithubBaseURL     string   `yaml:"github_base_url,omitempty"`
+	GithubToken       string   `yaml:"github_token,omitempty"`
+	GithubRepository  string   `yaml:"github_repository,omitempty"`
+	GithubTitle       string   `yaml:"github_title,omitempty"`
+	GithubLabels      []string `yaml:"github_labels,omitempty"`
+	GithubAssignees   []string `yaml:"github_assignees,omitempty"`
+	GithubFingerprint string   `yaml:"github_fingerprint,omitempty"`
+	GithubFormat      string   `yaml:"github_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.GithubBaseURL == "" {
+				o.GithubBaseURL = defaultBaseURL
+			}
+			provider.Github = append(provider.Github, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var GithubErr error
+	p.counter++
+	for _, pr := range p.Github {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.GithubFormat), p.counter)
+
+		if pr.GithubToken == "" || pr.GithubRepository == "" {
+			err := errors.Wrap(fmt.Errorf("github_token and github_repository values are required"),
+				fmt.Sprintf("failed to send github issue notification for id: %s ", pr.ID))
+			GithubErr = multierr.Append(GithubErr, err)
+			continue
+		}
+
+		if err := pr.send(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send github issue notification for id: %s ", pr.ID))
+			GithubErr = multierr.Append(GithubErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("github issue notification sent for id: %s", pr.ID)
+	}
+	return GithubErr
+}
+
+// send comments on an existing open issue labelled with the digest of the
+// fingerprint or opens a new one
+func (options *Options) send(msg, rawMessage string, counter int) error {
+	fingerprint, err := utils.RenderTemplate(options.GithubFingerprint, rawMessage, counter)
+	if err != nil {
+		return err
+	}
+	if fingerprint = strings.TrimSpace(fingerprint); fingerprint != "" {
+		fingerprint = utils.FingerprintLabel(fingerprint)
+	}
+
+	if fingerprint != "" {
+		number, err := options.FindIssue(fingerprint)
+		if err != nil {
+			return err
+		}
+		if number != 0 {
+			return options.AddComment(number, msg)
+		}
+	}
+
+	title, err := utils.RenderTemplate(options.GithubTitle, rawMessage, counter)
+	if err != nil {
+		return err
+	}
+	if title = strings.TrimSpace(title); title == "" {
+		title = strings.SplitN(strings.TrimSpace(msg), "\n", 2)[0]
+	}
+
+	issue := &CreateIssueRequest{Title: title, Body: msg, Assignees: options.GithubAssignees}
+	for _, label := range options.GithubLabels {
+		rendered, err := utils.RenderTemplate(label, rawMessage, counter)
+		if err != nil {
+			return err
+		}
+		if rendered = strings.TrimSpace(rendered); rendered != "" {
+			issue.Labels = append(issue.Labels, rendered)
+		}
+	}
+	if fingerprint != "" {
+		issue.Labels = append(issue.Labels, fingerprint)
+	}
+	return options.CreateIssue(issue)
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("github_token", options.GithubToken),
+		utils.Required("github_repository", options.GithubRepository),
+		utils.ValidURL("github_base_url", options.GithubBaseURL),
+	)
+	if options.GithubRepository != "" && !strings.Contains(options.GithubRepository, "/") {
+		err = multierr.Append(err, utils.NewFieldError("github_repository", "expected an owner/repository value"))
+	}
+	return err
+}
+
+// HealthCheck checks the token has access to the repository
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Github {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
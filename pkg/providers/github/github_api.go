@@ -0,0 +1,75 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// maxTitleLength is the maximum issue title length accepted by github
+const maxTitleLength = 256
+
+func (options *Options) endpoint(path string) string {
+	return fmt.Sprintf("%s/repos/%s/%s", strings.TrimSuffix(options.GithubBaseURL, "/"), options.GithubRepository, path)
+}
+
+func (options *Options) headers() http.Header {
+	return http.Header{
+		"Content-Type":         {"application/json"},
+		"Accept":               {"application/vnd.github+json"},
+		"Authorization":        {fmt.Sprintf("Bearer %s", options.GithubToken)},
+		"X-Github-Api-Version": {"2022-11-28"},
+	}
+}
+
+// FindIssue returns the number of the most recent open issue with the fingerprint label, if any
+func (options *Options) FindIssue(fingerprint string) (int, error) {
+	params := url.Values{
+		"state":    {"open"},
+		"labels":   {fingerprint},
+		"per_page": {"1"},
+	}
+
+	var issues []*Issue
+	if err := httpreq.NewClient().GetWithHeaders(options.endpoint("issues?"+params.Encode()), options.headers(), &issues); err != nil {
+		return 0, err
+	}
+	if len(issues) == 0 {
+		return 0, nil
+	}
+	return issues[0].Number, nil
+}
+
+func (options *Options) AddComment(number int, message string) error {
+	var response *Comment
+	err := httpreq.NewClient().Post(options.endpoint(fmt.Sprintf("issues/%d/comments", number)), &CommentRequest{Body: message}, options.headers(), &response)
+	if err != nil {
+		return err
+	}
+	if response.ID == 0 {
+		return fmt.Errorf("error while commenting on github issue #%d: %s", number, response.Message)
+	}
+	return nil
+}
+
+func (options *Options) CreateIssue(issue *CreateIssueRequest) error {
+	issue.Title = utils.Truncate(issue.Title, maxTitleLength)
+
+	var response *Issue
+	if err := httpreq.NewClient().Post(options.endpoint("issues"), issue, options.headers(), &response); err != nil {
+		return err
+	}
+	if response.Number == 0 {
+		return fmt.Errorf("error while creating github issue: %s", response.Message)
+	}
+	return nil
+}
+
+// CheckConnection checks the token has access to the repository
+func (options *Options) CheckConnection() error {
+	return httpreq.NewClient().Ping(strings.TrimSuffix(options.endpoint(""), "/"), options.headers())
+}
@@ -0,0 +1,44 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSendFingerprintLabel(t *testing.T) {
+	var searched string
+	var created CreateIssueRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			searched = r.URL.Query().Get("labels")
+			_, _ = w.Write([]byte(`[]`))
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_, _ = w.Write([]byte(`{"number":1}`))
+		}
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                "github",
+		GithubBaseURL:     server.URL,
+		GithubToken:       "token",
+		GithubRepository:  "owner/repo",
+		GithubFingerprint: "{{ .host }},{{ .template }}",
+	}}, nil)
+	message := `{"host":"https://a-rather-long-host-name.example.com/with/a/path","template":"exposed-panel"}`
+	if err := provider.Send(message, ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+
+	if !strings.HasPrefix(searched, "notify:") || len(searched) > 50 || strings.Contains(searched, ",") {
+		t.Errorf("expected a short label without commas, got %q", searched)
+	}
+	if len(created.Labels) != 1 || created.Labels[0] != searched {
+		t.Errorf("expected the issue to be labelled %q, got %v", searched, created.Labels)
+	}
+}
@@ -0,0 +1,27 @@
+package github
+
+type CreateIssueRequest struct {
+	Title     string   `json:"title"`
+	Body      string   `json:"body,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+}
+
+type CommentRequest struct {
+	Body string `json:"body"`
+}
+
+type Issue struct {
+	APIError
+	Number  int    `json:"number,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+type Comment struct {
+	APIError
+	ID int64 `json:"id,omitempty"`
+}
+
+type APIError struct {
+	Message string `json:"message,omitempty"`
+}
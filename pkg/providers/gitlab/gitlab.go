@@ -0,0 +1,142 @@
+package gitlab
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultBaseURL = "https://gitlab.com"
+
+type Provider struct {
+	Gitlab  []*Options `yaml:"gitlab_issues,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                string   `yaml:"id,omitempty"`
+	GitlabBaseURL     string   `yaml:"gitlab_base_url,omitempty"`
+	GitlabToken       string   `yaml:"gitlab_token,omitempty"`
+	GitlabProject     string   `yaml:"gitlab_project,omitempty"`
+	GitlabTitle       string   `yaml:"gitlab_title,omitempty"`
+	GitlabLabels      []string `yaml:"gitlab_labels,omitempty"`
+	GitlabAssigneeIDs []int    `yaml:"gitlab_assignee_ids,omitempty"`
+	GitlabFingerprint string   `yaml:"gitlab_fingerprint,omitempty"`
+	GitlabFormat      string   `yaml:"gitlab_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.GitlabBaseURL == "" {
+				o.GitlabBaseURL = defaultBaseURL
+			}
+			provider.Gitlab = append(provider.Gitlab, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var GitlabErr error
+	p.counter++
+	for _, pr := range p.Gitlab {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.GitlabFormat), p.counter)
+
+		if pr.GitlabToken == "" || pr.GitlabProject == "" {
+			err := errors.Wrap(fmt.Errorf("gitlab_token and gitlab_project values are required"),
+				fmt.Sprintf("failed to send gitlab issue notification for id: %s ", pr.ID))
+			GitlabErr = multierr.Append(GitlabErr, err)
+			continue
+		}
+
+		if err := pr.send(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send gitlab issue notification for id: %s ", pr.ID))
+			GitlabErr = multierr.Append(GitlabErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("gitlab issue notification sent for id: %s", pr.ID)
+	}
+	return GitlabErr
+}
+
+// send comments on an existing open issue labelled with the digest of the
+// fingerprint or opens a new one
+func (options *Options) send(msg, rawMessage string, counter int) error {
+	fingerprint, err := utils.RenderTemplate(options.GitlabFingerprint, rawMessage, counter)
+	if err != nil {
+		return err
+	}
+	if fingerprint = strings.TrimSpace(fingerprint); fingerprint != "" {
+		fingerprint = utils.FingerprintLabel(fingerprint)
+	}
+
+	if fingerprint != "" {
+		iid, err := options.FindIssue(fingerprint)
+		if err != nil {
+			return err
+		}
+		if iid != 0 {
+			return options.AddNote(iid, msg)
+		}
+	}
+
+	title, err := utils.RenderTemplate(options.GitlabTitle, rawMessage, counter)
+	if err != nil {
+		return err
+	}
+	if title = strings.TrimSpace(title); title == "" {
+		title = strings.SplitN(strings.TrimSpace(msg), "\n", 2)[0]
+	}
+
+	var labels []string
+	for _, label := range options.GitlabLabels {
+		rendered, err := utils.RenderTemplate(label, rawMessage, counter)
+		if err != nil {
+			return err
+		}
+		if rendered = strings.TrimSpace(rendered); rendered != "" {
+			labels = append(labels, rendered)
+		}
+	}
+	if fingerprint != "" {
+		labels = append(labels, fingerprint)
+	}
+
+	issue := &CreateIssueRequest{
+		Title:       title,
+		Description: msg,
+		Labels:      strings.Join(labels, ","),
+		AssigneeIDs: options.GitlabAssigneeIDs,
+	}
+	return options.CreateIssue(issue)
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("gitlab_token", options.GitlabToken),
+		utils.Required("gitlab_project", options.GitlabProject),
+		utils.ValidURL("gitlab_base_url", options.GitlabBaseURL),
+	)
+}
+
+// HealthCheck checks the token has access to the project
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Gitlab {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
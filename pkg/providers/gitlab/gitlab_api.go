@@ -0,0 +1,80 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// maxTitleLength is the maximum issue title length accepted by gitlab
+const maxTitleLength = 255
+
+func (options *Options) endpoint(path string) string {
+	return fmt.Sprintf("%s/api/v4/projects/%s/%s", strings.TrimSuffix(options.GitlabBaseURL, "/"), url.PathEscape(options.GitlabProject), path)
+}
+
+func (options *Options) headers() http.Header {
+	return http.Header{
+		"Content-Type":  {"application/json"},
+		"Private-Token": {options.GitlabToken},
+	}
+}
+
+func (e APIError) String() string {
+	if e.Message != nil {
+		return fmt.Sprint(e.Message)
+	}
+	return e.Error
+}
+
+// FindIssue returns the iid of the most recent open issue with the fingerprint label, if any
+func (options *Options) FindIssue(fingerprint string) (int, error) {
+	params := url.Values{
+		"state":    {"opened"},
+		"labels":   {fingerprint},
+		"per_page": {"1"},
+	}
+
+	var issues []*Issue
+	if err := httpreq.NewClient().GetWithHeaders(options.endpoint("issues?"+params.Encode()), options.headers(), &issues); err != nil {
+		return 0, err
+	}
+	if len(issues) == 0 {
+		return 0, nil
+	}
+	return issues[0].IID, nil
+}
+
+func (options *Options) AddNote(iid int, message string) error {
+	var response *Note
+	err := httpreq.NewClient().Post(options.endpoint(fmt.Sprintf("issues/%d/notes", iid)), &NoteRequest{Body: message}, options.headers(), &response)
+	if err != nil {
+		return err
+	}
+	if response.ID == 0 {
+		return fmt.Errorf("error while commenting on gitlab issue #%d: %s", iid, response.APIError)
+	}
+	return nil
+}
+
+func (options *Options) CreateIssue(issue *CreateIssueRequest) error {
+	issue.Title = utils.Truncate(issue.Title, maxTitleLength)
+
+	var response *Issue
+	if err := httpreq.NewClient().Post(options.endpoint("issues"), issue, options.headers(), &response); err != nil {
+		return err
+	}
+	if response.IID == 0 {
+		return fmt.Errorf("error while creating gitlab issue: %s", response.APIError)
+	}
+	return nil
+}
+
+// CheckConnection checks the token has access to the project
+func (options *Options) CheckConnection() error {
+	return httpreq.NewClient().Ping(strings.TrimSuffix(options.endpoint(""), "/"), options.headers())
+}
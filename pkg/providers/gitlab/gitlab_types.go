@@ -0,0 +1,28 @@
+package gitlab
+
+type CreateIssueRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Labels      string `json:"labels,omitempty"`
+	AssigneeIDs []int  `json:"assignee_ids,omitempty"`
+}
+
+type NoteRequest struct {
+	Body string `json:"body"`
+}
+
+type Issue struct {
+	APIError
+	IID    int    `json:"iid,omitempty"`
+	WebURL string `json:"web_url,omitempty"`
+}
+
+type Note struct {
+	APIError
+	ID int64 `json:"id,omitempty"`
+}
+
+type APIError struct {
+	Message interface{} `json:"message,omitempty"`
+	Error   string      `json:"error,omitempty"`
+}
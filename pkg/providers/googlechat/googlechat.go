@@ -18,11 +18,16 @@ type Provider struct {
 }
 
 type Options struct {
-	ID               string `yaml:"id,omitempty"`
-	Space            string `yaml:"space,omitempty"`
-	Key              string `yaml:"key,omitempty"`
-	Token            string `yaml:"token,omitempty"`
-	GoogleChatFormat string `yaml:"google_chat_format,omitempty"`
+	ID                     string `yaml:"id,omitempty"`
+	Space                  string `yaml:"space,omitempty"`
+	Key                    string `yaml:"key,omitempty"`
+	Token                  string `yaml:"token,omitempty"`
+	GoogleChatThreadKey    string `yaml:"google_chat_thread_key,omitempty"`
+	GoogleChatReplyOption  string `yaml:"google_chat_reply_option,omitempty"`
+	GoogleChatCards        bool   `yaml:"google_chat_cards,omitempty"`
+	GoogleChatCardTitle    string `yaml:"google_chat_card_title,omitempty"`
+	GoogleChatCardSubtitle string `yaml:"google_chat_card_subtitle,omitempty"`
+	GoogleChatFormat       string `yaml:"google_chat_format,omitempty"`
 }
 
 func New(options []*Options, ids []string) (*Provider, error) {
@@ -44,8 +49,17 @@ func (p *Provider) Send(message, CliFormat string) error {
 	p.counter++
 	for _, pr := range p.GoogleChat {
 		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.GoogleChatFormat), p.counter)
-		url := fmt.Sprintf("googlechat://chat.googleapis.com/v1/spaces/%s/messages?key=%s&token=%s", pr.Space, pr.Key, pr.Token)
-		err := shoutrrr.Send(url, msg)
+
+		var err error
+		if pr.native() {
+			var payload *APIRequest
+			if payload, err = pr.buildRequest(msg, message, p.counter); err == nil {
+				err = pr.SendMessage(payload)
+			}
+		} else {
+			url := fmt.Sprintf("googlechat://chat.googleapis.com/v1/spaces/%s/messages?key=%s&token=%s", pr.Space, pr.Key, pr.Token)
+			err = shoutrrr.Send(url, msg)
+		}
 		if err != nil {
 			err = errors.Wrap(err, fmt.Sprintf("failed to send googleChat notification for id: %s ", pr.ID))
 			GoogleChatErr = multierr.Append(GoogleChatErr, err)
@@ -55,3 +69,14 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return GoogleChatErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("space", options.Space),
+		utils.Required("key", options.Key),
+		utils.Required("token", options.Token),
+		utils.OneOf("google_chat_reply_option", options.GoogleChatReplyOption,
+			"MESSAGE_REPLY_OPTION_UNSPECIFIED", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD", "REPLY_MESSAGE_OR_FAIL"),
+	)
+}
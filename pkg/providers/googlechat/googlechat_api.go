@@ -0,0 +1,96 @@
+package googlechat
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const (
+	messagesAPI = "https://chat.googleapis.com/v1/spaces/%s/messages"
+
+	defaultReplyOption = "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD"
+)
+
+// native reports whether the options need the chat API directly rather than shoutrrr
+func (options *Options) native() bool {
+	return options.GoogleChatThreadKey != "" || options.GoogleChatReplyOption != "" || options.GoogleChatCards
+}
+
+// buildRequest renders the templated fields of the options for the given message
+func (options *Options) buildRequest(message, rawMessage string, counter int) (*APIRequest, error) {
+	render := func(text string) (string, error) {
+		rendered, err := utils.RenderTemplate(text, rawMessage, counter)
+		return strings.TrimSpace(rendered), err
+	}
+
+	payload := &APIRequest{}
+	threadKey, err := render(options.GoogleChatThreadKey)
+	if err != nil {
+		return nil, err
+	}
+	if threadKey != "" {
+		payload.Thread = &Thread{ThreadKey: threadKey}
+	}
+
+	if !options.GoogleChatCards {
+		payload.Text = message
+		return payload, nil
+	}
+
+	title, err := render(options.GoogleChatCardTitle)
+	if err != nil {
+		return nil, err
+	}
+	if title == "" {
+		title = "notify"
+	}
+	subtitle, err := render(options.GoogleChatCardSubtitle)
+	if err != nil {
+		return nil, err
+	}
+	payload.CardsV2 = []*CardV2{{
+		CardID: fmt.Sprintf("notify-%d", counter),
+		Card: &Card{
+			Header: &CardHeader{Title: title, Subtitle: subtitle},
+			Sections: []*CardSection{{
+				Widgets: []*CardWidget{{TextParagraph: &TextParagraph{Text: message}}},
+			}},
+		},
+	}}
+	return payload, nil
+}
+
+// SendMessage posts the payload to the space webhook
+func (options *Options) SendMessage(payload *APIRequest) error {
+	params := url.Values{
+		"key":   {options.Key},
+		"token": {options.Token},
+	}
+	if payload.Thread != nil || options.GoogleChatReplyOption != "" {
+		// the API only accepts the uppercase enum names
+		replyOption := strings.ToUpper(options.GoogleChatReplyOption)
+		if replyOption == "" {
+			replyOption = defaultReplyOption
+		}
+		params.Set("messageReplyOption", replyOption)
+	}
+
+	headers := http.Header{
+		"Content-Type": {"application/json; charset=UTF-8"},
+	}
+
+	var response *APIResponse
+	apiURL := fmt.Sprintf(messagesAPI, url.PathEscape(options.Space)) + "?" + params.Encode()
+	if err := httpreq.NewClient().Post(apiURL, payload, headers, &response); err != nil {
+		return err
+	}
+	if response.Error != nil {
+		return fmt.Errorf("error while sending google chat message: %s %s", response.Error.Status, response.Error.Message)
+	}
+	return nil
+}
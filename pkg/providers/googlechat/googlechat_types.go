@@ -0,0 +1,49 @@
+package googlechat
+
+type APIRequest struct {
+	Text    string    `json:"text,omitempty"`
+	Thread  *Thread   `json:"thread,omitempty"`
+	CardsV2 []*CardV2 `json:"cardsV2,omitempty"`
+}
+
+type Thread struct {
+	ThreadKey string `json:"threadKey,omitempty"`
+}
+
+type CardV2 struct {
+	CardID string `json:"cardId"`
+	Card   *Card  `json:"card"`
+}
+
+type Card struct {
+	Header   *CardHeader    `json:"header,omitempty"`
+	Sections []*CardSection `json:"sections"`
+}
+
+type CardHeader struct {
+	Title    string `json:"title"`
+	Subtitle string `json:"subtitle,omitempty"`
+}
+
+type CardSection struct {
+	Widgets []*CardWidget `json:"widgets"`
+}
+
+type CardWidget struct {
+	TextParagraph *TextParagraph `json:"textParagraph,omitempty"`
+}
+
+type TextParagraph struct {
+	Text string `json:"text"`
+}
+
+type APIResponse struct {
+	Name  string    `json:"name,omitempty"`
+	Error *APIError `json:"error,omitempty"`
+}
+
+type APIError struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
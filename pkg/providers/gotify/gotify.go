@@ -1,7 +1,12 @@
 package gotify
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
 	"net/url"
 
 	"github.com/containrrr/shoutrrr"
@@ -10,9 +15,13 @@ import (
 
 	"github.com/projectdiscovery/gologger"
 	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
 	sliceutil "github.com/projectdiscovery/utils/slice"
 )
 
+// defaultTitle is the message title set by shoutrrr
+const defaultTitle = "Shoutrrr notification"
+
 type Provider struct {
 	Gotify  []*Options `yaml:"gotify,omitempty"`
 	counter int
@@ -70,3 +79,82 @@ func (p *Provider) Send(message, CliFormat string) error {
 
 	return GotifyErr
 }
+
+// DryRun writes the requests that would be sent to the servers
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var GotifyErr error
+	p.counter++
+	for _, pr := range p.Gotify {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.GotifyFormat), p.counter)
+
+		var dump bytes.Buffer
+		req, err := pr.messageRequest(msg)
+		if err == nil {
+			err = httpreq.DumpRequest(&dump, req)
+		}
+		if err == nil {
+			err = utils.WriteDryRun(w, pr.ID, dump.String())
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run gotify notification for id: %s ", pr.ID))
+			GotifyErr = multierr.Append(GotifyErr, err)
+		}
+	}
+	return GotifyErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("gotify_host", options.GotifyHost),
+		utils.Required("gotify_token", options.GotifyToken),
+	)
+}
+
+// HealthCheck queries the /health endpoint of the gotify server
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Gotify {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
+
+// CheckConnection queries the health endpoint of the server
+func (options *Options) CheckConnection() error {
+	return httpreq.NewClient().Ping(options.baseURL()+"/health", nil)
+}
+
+// baseURL returns the url of the server, https unless tls is disabled
+func (options *Options) baseURL() string {
+	scheme := "https"
+	if options.GotifyDisableTLS {
+		scheme = "http"
+	}
+	host := options.GotifyHost
+	if options.GotifyPort != "" {
+		host = net.JoinHostPort(options.GotifyHost, options.GotifyPort)
+	}
+	return fmt.Sprintf("%s://%s", scheme, host)
+}
+
+// messageRequest returns the request shoutrrr sends to the message endpoint,
+// gotify is delivered through its own http client and can't be dry run by
+// the default transport
+func (options *Options) messageRequest(msg string) (*http.Request, error) {
+	title := options.GotifyTitle
+	if title == "" {
+		title = defaultTitle
+	}
+	body, err := json.Marshal(map[string]interface{}{"message": msg, "title": title, "priority": 0})
+	if err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("%s/message?token=%s", options.baseURL(), url.QueryEscape(options.GotifyToken))
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
@@ -0,0 +1,84 @@
+package providers
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// HealthChecker is implemented by providers able to check their destinations
+// without posting a message
+type HealthChecker interface {
+	HealthCheck() []*utils.HealthCheckResult
+}
+
+// TestResult is the connectivity self-test outcome of a provider id
+type TestResult struct {
+	Provider string
+	*utils.HealthCheckResult
+}
+
+// Test checks every selected provider id, providers without a read-only
+// check are sent a clearly marked test message for each id
+func (p *Client) Test() []*TestResult {
+	var results []*TestResult
+	for _, provider := range p.providers {
+		name, index := optionsField(provider)
+
+		var checks []*utils.HealthCheckResult
+		if checker, ok := provider.(HealthChecker); ok {
+			checks = checker.HealthCheck()
+		} else if index >= 0 {
+			options := reflect.ValueOf(provider).Elem().Field(index)
+			for i := 0; i < options.Len(); i++ {
+				single := singleOptionProvider(provider, index, options.Index(i))
+				id := reflect.Indirect(options.Index(i)).FieldByName("ID").String()
+				checks = append(checks, utils.CheckHealth(id, true, func() error {
+					return single.Send(utils.TestMessage, "")
+				}))
+			}
+		}
+		for _, check := range checks {
+			results = append(results, &TestResult{Provider: name, HealthCheckResult: check})
+		}
+	}
+	return results
+}
+
+// optionsField returns the provider config name and the index of the
+// options list of a provider, every provider keeps its options in a single
+// exported slice field
+func optionsField(provider Provider) (string, int) {
+	t := reflect.TypeOf(provider).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.IsExported() && field.Type.Kind() == reflect.Slice {
+			return configName(field), i
+		}
+	}
+	return strings.ToLower(t.Name()), -1
+}
+
+// configName returns the name of the ProviderOptions field of the same type
+// as the options list, the yaml tags of the provider structs may differ
+func configName(list reflect.StructField) string {
+	t := reflect.TypeOf(ProviderOptions{})
+	for i := 0; i < t.NumField(); i++ {
+		if name, ok := providerName(t.Field(i)); ok && t.Field(i).Type == list.Type {
+			return name
+		}
+	}
+	name, _, _ := strings.Cut(list.Tag.Get("yaml"), ",")
+	return name
+}
+
+// singleOptionProvider returns a copy of the provider restricted to one options entry
+func singleOptionProvider(provider Provider, index int, option reflect.Value) Provider {
+	single := reflect.New(reflect.TypeOf(provider).Elem())
+	single.Elem().Set(reflect.ValueOf(provider).Elem())
+	list := reflect.MakeSlice(single.Elem().Field(index).Type(), 1, 1)
+	list.Index(0).Set(option)
+	single.Elem().Field(index).Set(list)
+	return single.Interface().(Provider)
+}
@@ -0,0 +1,51 @@
+package providers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/projectdiscovery/notify/pkg/providers/custom"
+	"github.com/projectdiscovery/notify/pkg/types"
+)
+
+func TestClientTest(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if r.URL.Path == "/broken" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(&ProviderOptions{Custom: []*custom.Options{
+		{ID: "ok", CustomWebhookURL: server.URL + "/ok", CustomMethod: http.MethodPost},
+		{ID: "broken", CustomWebhookURL: server.URL + "/broken", CustomMethod: http.MethodPost},
+	}}, &types.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := client.Test()
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, result := range results {
+		if result.Provider != "custom" || !result.Sent {
+			t.Errorf("unexpected result %+v", result)
+		}
+	}
+	if results[0].ID != "ok" || results[0].Err != nil {
+		t.Errorf("expected id ok to pass, got %+v", results[0])
+	}
+	if results[1].ID != "broken" || results[1].Err == nil {
+		t.Errorf("expected id broken to fail, got %+v", results[1])
+	}
+	if len(bodies) != 2 || !strings.Contains(bodies[0], "test") {
+		t.Errorf("expected a test message per id, got %q", bodies)
+	}
+}
@@ -0,0 +1,137 @@
+package jira
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultIssueType = "Task"
+
+type Provider struct {
+	Jira    []*Options `yaml:"jira,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID               string                 `yaml:"id,omitempty"`
+	JiraURL          string                 `yaml:"jira_url,omitempty"`
+	JiraEmail        string                 `yaml:"jira_email,omitempty"`
+	JiraAPIToken     string                 `yaml:"jira_api_token,omitempty"`
+	JiraPAT          string                 `yaml:"jira_pat,omitempty"`
+	JiraProject      string                 `yaml:"jira_project,omitempty"`
+	JiraIssueType    string                 `yaml:"jira_issue_type,omitempty"`
+	JiraSummary      string                 `yaml:"jira_summary,omitempty"`
+	JiraLabels       []string               `yaml:"jira_labels,omitempty"`
+	JiraComponents   []string               `yaml:"jira_components,omitempty"`
+	JiraPriority     string                 `yaml:"jira_priority,omitempty"`
+	JiraCustomFields map[string]interface{} `yaml:"jira_custom_fields,omitempty"`
+	JiraDedupLabel   string                 `yaml:"jira_dedup_label,omitempty"`
+	JiraDedupJQL     string                 `yaml:"jira_dedup_jql,omitempty"`
+	JiraFormat       string                 `yaml:"jira_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.JiraIssueType == "" {
+				o.JiraIssueType = defaultIssueType
+			}
+			provider.Jira = append(provider.Jira, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var JiraErr error
+	p.counter++
+	for _, pr := range p.Jira {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.JiraFormat), p.counter)
+
+		if pr.JiraURL == "" || pr.JiraProject == "" {
+			err := errors.Wrap(fmt.Errorf("jira_url and jira_project values are required"),
+				fmt.Sprintf("failed to send jira notification for id: %s ", pr.ID))
+			JiraErr = multierr.Append(JiraErr, err)
+			continue
+		}
+		if pr.JiraPAT == "" && (pr.JiraEmail == "" || pr.JiraAPIToken == "") {
+			err := errors.Wrap(fmt.Errorf("jira_pat or jira_email and jira_api_token values are required"),
+				fmt.Sprintf("failed to send jira notification for id: %s ", pr.ID))
+			JiraErr = multierr.Append(JiraErr, err)
+			continue
+		}
+
+		if err := pr.send(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send jira notification for id: %s ", pr.ID))
+			JiraErr = multierr.Append(JiraErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("jira notification sent for id: %s", pr.ID)
+	}
+	return JiraErr
+}
+
+// send comments on an existing open issue matching the dedup query or creates a new one
+func (options *Options) send(msg, rawMessage string, counter int) error {
+	render := newRenderer(rawMessage, counter)
+
+	label, err := render(options.JiraDedupLabel)
+	if err != nil {
+		return err
+	}
+	label = labelize(label)
+
+	jql, err := options.dedupJQL(label, render)
+	if err != nil {
+		return err
+	}
+	if jql != "" {
+		key, err := options.FindIssue(jql)
+		if err != nil {
+			return err
+		}
+		if key != "" {
+			return options.AddComment(key, msg)
+		}
+	}
+
+	fields, err := options.buildFields(msg, label, render)
+	if err != nil {
+		return err
+	}
+	_, err = options.CreateIssue(fields)
+	return err
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("jira_url", options.JiraURL),
+		utils.ValidURL("jira_url", options.JiraURL),
+		utils.Required("jira_project", options.JiraProject),
+	)
+	if options.JiraPAT == "" && (options.JiraEmail == "" || options.JiraAPIToken == "") {
+		err = multierr.Append(err, fmt.Errorf("jira_pat or jira_email and jira_api_token values are required"))
+	}
+	return err
+}
+
+// HealthCheck checks the credentials with the myself endpoint
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Jira {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
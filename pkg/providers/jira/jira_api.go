@@ -0,0 +1,202 @@
+package jira
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// maxSummaryLength is the maximum issue summary length accepted by jira
+const maxSummaryLength = 255
+
+// isCloud reports whether the options use Jira Cloud (email + API token) authentication
+func (options *Options) isCloud() bool {
+	return options.JiraPAT == ""
+}
+
+func (options *Options) endpoint(path string) string {
+	return strings.TrimSuffix(options.JiraURL, "/") + "/rest/api/2/" + path
+}
+
+func (options *Options) headers() http.Header {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+		"Accept":       {"application/json"},
+	}
+	if options.isCloud() {
+		credentials := base64.StdEncoding.EncodeToString([]byte(options.JiraEmail + ":" + options.JiraAPIToken))
+		headers.Set("Authorization", fmt.Sprintf("Basic %s", credentials))
+	} else {
+		headers.Set("Authorization", fmt.Sprintf("Bearer %s", options.JiraPAT))
+	}
+	return headers
+}
+
+// dedupJQL returns the rendered query used to find an existing open issue
+func (options *Options) dedupJQL(label string, render func(string) (string, error)) (string, error) {
+	if options.JiraDedupJQL != "" {
+		return render(options.JiraDedupJQL)
+	}
+	if label == "" {
+		return "", nil
+	}
+	return fmt.Sprintf(`project = %q AND labels = %q AND statusCategory != Done ORDER BY created DESC`, options.JiraProject, label), nil
+}
+
+// FindIssue returns the key of the most recent issue matching the query, if any
+func (options *Options) FindIssue(jql string) (string, error) {
+	// jira cloud has retired the search endpoint in favour of search/jql
+	path := "search"
+	if options.isCloud() {
+		path = "search/jql"
+	}
+
+	var response *SearchResponse
+	payload := &SearchRequest{JQL: jql, MaxResults: 1, Fields: []string{"key"}}
+	if err := httpreq.NewClient().Post(options.endpoint(path), payload, options.headers(), &response); err != nil {
+		return "", err
+	}
+	if len(response.ErrorMessages) > 0 || len(response.Errors) > 0 {
+		return "", fmt.Errorf("error while searching jira issues: %s", response.APIError)
+	}
+	if len(response.Issues) == 0 {
+		return "", nil
+	}
+	return response.Issues[0].Key, nil
+}
+
+func (options *Options) AddComment(key, message string) error {
+	var response *CommentResponse
+	err := httpreq.NewClient().Post(options.endpoint("issue/"+url.PathEscape(key)+"/comment"), &CommentRequest{Body: message}, options.headers(), &response)
+	if err != nil {
+		return err
+	}
+	if response.ID == "" {
+		return fmt.Errorf("error while commenting on jira issue %s: %s", key, response.APIError)
+	}
+	return nil
+}
+
+func (options *Options) CreateIssue(fields map[string]interface{}) (string, error) {
+	var response *CreateIssueResponse
+	err := httpreq.NewClient().Post(options.endpoint("issue"), &CreateIssueRequest{Fields: fields}, options.headers(), &response)
+	if err != nil {
+		return "", err
+	}
+	if response.Key == "" {
+		return "", fmt.Errorf("error while creating jira issue: %s", response.APIError)
+	}
+	return response.Key, nil
+}
+
+// buildFields renders the templated fields of the options into an issue fields object
+func (options *Options) buildFields(message, label string, render func(string) (string, error)) (map[string]interface{}, error) {
+	summary, err := render(options.JiraSummary)
+	if err != nil {
+		return nil, err
+	}
+	if summary = strings.TrimSpace(summary); summary == "" {
+		summary = strings.SplitN(strings.TrimSpace(message), "\n", 2)[0]
+	}
+	summary = utils.Truncate(summary, maxSummaryLength)
+
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": options.JiraProject},
+		"issuetype":   map[string]string{"name": options.JiraIssueType},
+		"summary":     summary,
+		"description": message,
+	}
+
+	var labels []string
+	for _, l := range options.JiraLabels {
+		rendered, err := render(l)
+		if err != nil {
+			return nil, err
+		}
+		if rendered = labelize(rendered); rendered != "" {
+			labels = append(labels, rendered)
+		}
+	}
+	if label != "" {
+		labels = append(labels, label)
+	}
+	if len(labels) > 0 {
+		fields["labels"] = labels
+	}
+
+	if len(options.JiraComponents) > 0 {
+		var components []map[string]string
+		for _, component := range options.JiraComponents {
+			components = append(components, map[string]string{"name": component})
+		}
+		fields["components"] = components
+	}
+
+	priority, err := render(options.JiraPriority)
+	if err != nil {
+		return nil, err
+	}
+	if priority = strings.TrimSpace(priority); priority != "" {
+		fields["priority"] = map[string]string{"name": priority}
+	}
+
+	for field, value := range options.JiraCustomFields {
+		rendered, err := renderValue(value, render)
+		if err != nil {
+			return nil, err
+		}
+		fields[field] = rendered
+	}
+	return fields, nil
+}
+
+// renderValue renders all the string leaves of a custom field value
+func renderValue(value interface{}, render func(string) (string, error)) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return render(v)
+	case []interface{}:
+		rendered := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			r, err := renderValue(item, render)
+			if err != nil {
+				return nil, err
+			}
+			rendered = append(rendered, r)
+		}
+		return rendered, nil
+	case map[string]interface{}:
+		rendered := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			r, err := renderValue(item, render)
+			if err != nil {
+				return nil, err
+			}
+			rendered[key] = r
+		}
+		return rendered, nil
+	default:
+		return value, nil
+	}
+}
+
+// labelize converts a value into a valid jira label, which can't contain spaces
+func labelize(value string) string {
+	return strings.Join(strings.Fields(value), "-")
+}
+
+func newRenderer(rawMessage string, counter int) func(string) (string, error) {
+	return func(text string) (string, error) {
+		return utils.RenderTemplate(text, rawMessage, counter)
+	}
+}
+
+// CheckConnection checks the credentials with the myself endpoint
+func (options *Options) CheckConnection() error {
+	return httpreq.NewClient().Ping(options.endpoint("myself"), options.headers())
+}
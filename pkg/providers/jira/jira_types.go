@@ -0,0 +1,54 @@
+package jira
+
+import (
+	"sort"
+	"strings"
+)
+
+type CreateIssueRequest struct {
+	Fields map[string]interface{} `json:"fields"`
+}
+
+type CreateIssueResponse struct {
+	APIError
+	Key string `json:"key,omitempty"`
+}
+
+type SearchRequest struct {
+	JQL        string   `json:"jql"`
+	MaxResults int      `json:"maxResults"`
+	Fields     []string `json:"fields"`
+}
+
+type SearchResponse struct {
+	APIError
+	Issues []struct {
+		Key string `json:"key"`
+	} `json:"issues,omitempty"`
+}
+
+type CommentRequest struct {
+	Body string `json:"body"`
+}
+
+type CommentResponse struct {
+	APIError
+	ID string `json:"id,omitempty"`
+}
+
+type APIError struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
+func (e APIError) String() string {
+	messages := append([]string{}, e.ErrorMessages...)
+	for field, message := range e.Errors {
+		messages = append(messages, field+": "+message)
+	}
+	if len(messages) == 0 {
+		return "unknown error"
+	}
+	sort.Strings(messages)
+	return strings.Join(messages, ", ")
+}
@@ -0,0 +1,129 @@
+package loki
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/utils/batcher"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+)
+
+type Provider struct {
+	Loki    []*Options `yaml:"loki,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                string            `yaml:"id,omitempty"`
+	LokiURL           string            `yaml:"loki_url,omitempty"`
+	LokiLabels        map[string]string `yaml:"loki_labels,omitempty"`
+	LokiTenantID      string            `yaml:"loki_tenant_id,omitempty"`
+	LokiUsername      string            `yaml:"loki_username,omitempty"`
+	LokiPassword      string            `yaml:"loki_password,omitempty"`
+	LokiToken         string            `yaml:"loki_token,omitempty"`
+	LokiBatchSize     int               `yaml:"loki_batch_size,omitempty"`
+	LokiFlushInterval time.Duration     `yaml:"loki_flush_interval,omitempty"`
+	LokiFormat        string            `yaml:"loki_format,omitempty"`
+
+	batcher *batcher.Batcher[*entry]
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.LokiBatchSize <= 0 {
+				o.LokiBatchSize = defaultBatchSize
+			}
+			if o.LokiFlushInterval <= 0 {
+				o.LokiFlushInterval = defaultFlushInterval
+			}
+			o.batcher = batcher.New[*entry](
+				batcher.WithMaxCapacity[*entry](o.LokiBatchSize),
+				batcher.WithFlushInterval[*entry](o.LokiFlushInterval),
+				batcher.WithFlushCallback[*entry](o.flush),
+			)
+			o.batcher.Run()
+			provider.Loki = append(provider.Loki, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var LokiErr error
+	p.counter++
+	for _, pr := range p.Loki {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.LokiFormat), p.counter)
+
+		if pr.LokiURL == "" {
+			err := errors.Wrap(fmt.Errorf("loki_url value is required"),
+				fmt.Sprintf("failed to send loki notification for id: %s ", pr.ID))
+			LokiErr = multierr.Append(LokiErr, err)
+			continue
+		}
+
+		labels, err := pr.renderLabels(message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render loki labels for id: %s ", pr.ID))
+			LokiErr = multierr.Append(LokiErr, err)
+			continue
+		}
+		pr.batcher.Append(&entry{
+			labels:    labels,
+			timestamp: strconv.FormatInt(time.Now().UnixNano(), 10),
+			line:      msg,
+		})
+	}
+	return LokiErr
+}
+
+// flush delivers a batch of buffered entries
+func (options *Options) flush(entries []*entry) {
+	if err := options.SendEntries(entries); err != nil {
+		gologger.Error().Msgf("failed to send loki notification for id: %s: %s", options.ID, err)
+		return
+	}
+	gologger.Verbose().Msgf("loki notification sent for id: %s (%d entries)", options.ID, len(entries))
+}
+
+// Close flushes the buffered entries
+func (p *Provider) Close() error {
+	for _, pr := range p.Loki {
+		pr.batcher.Stop()
+		pr.batcher.WaitDone()
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("loki_url", options.LokiURL),
+		utils.ValidURL("loki_url", options.LokiURL),
+	)
+}
+
+// HealthCheck queries the readiness endpoint of loki
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Loki {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
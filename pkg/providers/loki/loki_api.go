@@ -0,0 +1,120 @@
+package loki
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const (
+	pushAPIPath  = "/loki/api/v1/push"
+	readyAPIPath = "/ready"
+)
+
+// renderLabels renders the templated stream labels for the given message
+func (options *Options) renderLabels(rawMessage string, counter int) (map[string]string, error) {
+	labels := make(map[string]string, len(options.LokiLabels))
+	for name, value := range options.LokiLabels {
+		rendered, err := utils.RenderTemplate(value, rawMessage, counter)
+		if err != nil {
+			return nil, err
+		}
+		if rendered = strings.TrimSpace(rendered); rendered != "" {
+			labels[name] = rendered
+		}
+	}
+	if len(labels) == 0 {
+		labels["job"] = "notify"
+	}
+	return labels, nil
+}
+
+// streamKey returns a stable identifier for a label set
+func streamKey(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var key strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&key, "%s=%q,", name, labels[name])
+	}
+	return key.String()
+}
+
+// buildPushRequest groups the entries by stream labels
+func buildPushRequest(entries []*entry) *PushRequest {
+	request := &PushRequest{}
+	streams := make(map[string]*Stream)
+	for _, e := range entries {
+		key := streamKey(e.labels)
+		stream, ok := streams[key]
+		if !ok {
+			stream = &Stream{Stream: e.labels}
+			streams[key] = stream
+			request.Streams = append(request.Streams, stream)
+		}
+		stream.Values = append(stream.Values, [2]string{e.timestamp, e.line})
+	}
+	return request
+}
+
+// SendEntries pushes a batch of entries to loki
+func (options *Options) SendEntries(entries []*entry) error {
+	body, err := json.Marshal(buildPushRequest(entries))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(options.LokiURL, "/")+pushAPIPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range options.headers() {
+		req.Header[key] = value
+	}
+
+	res, err := httpreq.NewClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		response, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("error while pushing loki entries: %d %s", res.StatusCode, strings.TrimSpace(string(response)))
+	}
+	return nil
+}
+
+// headers returns the tenant and authorization headers
+func (options *Options) headers() http.Header {
+	headers := http.Header{}
+	if options.LokiTenantID != "" {
+		headers.Set("X-Scope-OrgID", options.LokiTenantID)
+	}
+	switch {
+	case options.LokiToken != "":
+		headers.Set("Authorization", fmt.Sprintf("Bearer %s", options.LokiToken))
+	case options.LokiUsername != "":
+		credentials := base64.StdEncoding.EncodeToString([]byte(options.LokiUsername + ":" + options.LokiPassword))
+		headers.Set("Authorization", fmt.Sprintf("Basic %s", credentials))
+	}
+	return headers
+}
+
+// CheckConnection queries the readiness endpoint
+func (options *Options) CheckConnection() error {
+	return httpreq.NewClient().Ping(strings.TrimSuffix(options.LokiURL, "/")+readyAPIPath, options.headers())
+}
@@ -0,0 +1,59 @@
+package loki
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSendBatches(t *testing.T) {
+	var requests []*PushRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != pushAPIPath || r.Header.Get("X-Scope-OrgID") != "tenant" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var request *PushRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		requests = append(requests, request)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                "loki",
+		LokiURL:           server.URL,
+		LokiTenantID:      "tenant",
+		LokiLabels:        map[string]string{"job": "notify", "host": "{{ .host }}"},
+		LokiFlushInterval: time.Hour,
+	}}, nil)
+
+	for _, message := range []string{`{"host":"a.example.com"}`, `{"host":"b.example.com"}`, `{"host":"a.example.com"}`} {
+		if err := provider.Send(message, ""); err != nil {
+			t.Fatalf("could not send message: %s", err)
+		}
+	}
+	if len(requests) != 0 {
+		t.Fatalf("expected entries to be buffered, got %d requests", len(requests))
+	}
+
+	if err := provider.Close(); err != nil {
+		t.Fatalf("could not close provider: %s", err)
+	}
+	if len(requests) != 1 {
+		t.Fatalf("expected a single batched request, got %d", len(requests))
+	}
+
+	streams := requests[0].Streams
+	if len(streams) != 2 {
+		t.Fatalf("expected entries grouped in 2 streams, got %d", len(streams))
+	}
+	if streams[0].Stream["host"] != "a.example.com" || len(streams[0].Values) != 2 {
+		t.Errorf("unexpected first stream: %+v", streams[0])
+	}
+	if streams[1].Stream["host"] != "b.example.com" || len(streams[1].Values) != 1 {
+		t.Errorf("unexpected second stream: %+v", streams[1])
+	}
+}
@@ -0,0 +1,17 @@
+package loki
+
+type PushRequest struct {
+	Streams []*Stream `json:"streams"`
+}
+
+type Stream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// entry is a rendered log line with its stream labels
+type entry struct {
+	labels    map[string]string
+	timestamp string
+	line      string
+}
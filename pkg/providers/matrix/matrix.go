@@ -0,0 +1,103 @@
+package matrix
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultHomeserver = "https://matrix.org"
+
+type Provider struct {
+	Matrix  []*Options `yaml:"matrix,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                string   `yaml:"id,omitempty"`
+	MatrixHomeserver  string   `yaml:"matrix_homeserver,omitempty"`
+	MatrixAccessToken string   `yaml:"matrix_access_token,omitempty"`
+	MatrixUser        string   `yaml:"matrix_user,omitempty"`
+	MatrixPassword    string   `yaml:"matrix_password,omitempty"`
+	MatrixRooms       []string `yaml:"matrix_rooms,omitempty"`
+	MatrixHTML        bool     `yaml:"matrix_html,omitempty"`
+	MatrixReplyTo     string   `yaml:"matrix_reply_to,omitempty"`
+	MatrixThreads     bool     `yaml:"matrix_threads,omitempty"`
+	MatrixFormat      string   `yaml:"matrix_format,omitempty"`
+
+	joinedRooms map[string]string
+	threadRoots map[string]string
+	txnCounter  int
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.MatrixHomeserver == "" {
+				o.MatrixHomeserver = defaultHomeserver
+			}
+			provider.Matrix = append(provider.Matrix, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var MatrixErr error
+	p.counter++
+	for _, pr := range p.Matrix {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.MatrixFormat), p.counter)
+
+		if pr.MatrixAccessToken == "" && (pr.MatrixUser == "" || pr.MatrixPassword == "") {
+			err := errors.Wrap(fmt.Errorf("matrix_access_token or matrix_user and matrix_password values are required"),
+				fmt.Sprintf("failed to send matrix notification for id: %s ", pr.ID))
+			MatrixErr = multierr.Append(MatrixErr, err)
+			continue
+		}
+
+		var sent bool
+		for _, room := range pr.MatrixRooms {
+			if err := pr.SendMessage(room, msg); err != nil {
+				err = errors.Wrap(err, fmt.Sprintf("failed to send matrix notification to room %s for id: %s ", room, pr.ID))
+				MatrixErr = multierr.Append(MatrixErr, err)
+				continue
+			}
+			sent = true
+		}
+		if sent {
+			gologger.Verbose().Msgf("matrix notification sent for id: %s", pr.ID)
+		}
+	}
+	return MatrixErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.ValidURL("matrix_homeserver", options.MatrixHomeserver),
+		utils.RequiredList("matrix_rooms", options.MatrixRooms),
+	)
+	if options.MatrixAccessToken == "" && (options.MatrixUser == "" || options.MatrixPassword == "") {
+		err = multierr.Append(err, fmt.Errorf("matrix_access_token or matrix_user and matrix_password values are required"))
+	}
+	return err
+}
+
+// HealthCheck checks the credentials with the whoami endpoint
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Matrix {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
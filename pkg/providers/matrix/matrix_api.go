@@ -0,0 +1,142 @@
+package matrix
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const clientAPIPath = "/_matrix/client/v3"
+
+var reHTMLTags = regexp.MustCompile(`<[^>]*>`)
+
+func (options *Options) endpoint(path ...string) string {
+	escaped := make([]string, 0, len(path))
+	for _, p := range path {
+		escaped = append(escaped, url.PathEscape(p))
+	}
+	return strings.TrimSuffix(options.MatrixHomeserver, "/") + clientAPIPath + "/" + strings.Join(escaped, "/")
+}
+
+func (options *Options) headers() http.Header {
+	return http.Header{
+		"Content-Type":  {"application/json"},
+		"Authorization": {fmt.Sprintf("Bearer %s", options.MatrixAccessToken)},
+	}
+}
+
+// login exchanges the configured user and password for an access token
+func (options *Options) login() error {
+	payload := LoginRequest{
+		Type:       "m.login.password",
+		Identifier: LoginIdentifier{Type: "m.id.user", User: options.MatrixUser},
+		Password:   options.MatrixPassword,
+	}
+	headers := http.Header{"Content-Type": {"application/json"}}
+
+	var response *LoginResponse
+	if err := httpreq.NewClient().Post(options.endpoint("login"), &payload, headers, &response); err != nil {
+		return err
+	}
+	if response.AccessToken == "" {
+		return fmt.Errorf("error while logging in to matrix: %s %s", response.ErrCode, response.Error)
+	}
+	options.MatrixAccessToken = response.AccessToken
+	return nil
+}
+
+// joinRoom joins the given room id or alias and returns the resolved room id
+func (options *Options) joinRoom(room string) (string, error) {
+	if roomID, ok := options.joinedRooms[room]; ok {
+		return roomID, nil
+	}
+
+	var response *JoinResponse
+	if err := httpreq.NewClient().Post(options.endpoint("join", room), struct{}{}, options.headers(), &response); err != nil {
+		return "", err
+	}
+	if response.RoomID == "" {
+		return "", fmt.Errorf("error while joining matrix room %s: %s %s", room, response.ErrCode, response.Error)
+	}
+
+	if options.joinedRooms == nil {
+		options.joinedRooms = make(map[string]string)
+	}
+	options.joinedRooms[room] = response.RoomID
+	return response.RoomID, nil
+}
+
+func (options *Options) buildEvent(roomID, message string) *MessageEvent {
+	event := &MessageEvent{
+		MsgType: "m.text",
+		Body:    message,
+	}
+	if options.MatrixHTML {
+		event.Format = "org.matrix.custom.html"
+		event.FormattedBody = message
+		event.Body = reHTMLTags.ReplaceAllString(message, "")
+	}
+
+	threadRoot := options.threadRoots[roomID]
+	switch {
+	case options.MatrixThreads && threadRoot != "":
+		event.RelatesTo = &RelatesTo{
+			RelType:       "m.thread",
+			EventID:       threadRoot,
+			IsFallingBack: true,
+			InReplyTo:     &InReplyTo{EventID: threadRoot},
+		}
+	case options.MatrixReplyTo != "":
+		event.RelatesTo = &RelatesTo{InReplyTo: &InReplyTo{EventID: options.MatrixReplyTo}}
+	}
+	return event
+}
+
+// SendMessage sends a m.room.message event to the given room id or alias
+func (options *Options) SendMessage(room, message string) error {
+	if options.MatrixAccessToken == "" {
+		if err := options.login(); err != nil {
+			return err
+		}
+	}
+
+	roomID, err := options.joinRoom(room)
+	if err != nil {
+		return err
+	}
+
+	options.txnCounter++
+	txnID := fmt.Sprintf("notify-%d-%d", time.Now().UnixNano(), options.txnCounter)
+
+	var response *SendResponse
+	err = httpreq.NewClient().Put(options.endpoint("rooms", roomID, "send", "m.room.message", txnID), options.buildEvent(roomID, message), options.headers(), &response)
+	if err != nil {
+		return err
+	}
+	if response.EventID == "" {
+		return fmt.Errorf("error while sending matrix message: %s %s", response.ErrCode, response.Error)
+	}
+
+	if options.MatrixThreads && options.threadRoots[roomID] == "" {
+		if options.threadRoots == nil {
+			options.threadRoots = make(map[string]string)
+		}
+		options.threadRoots[roomID] = response.EventID
+	}
+	return nil
+}
+
+// CheckConnection checks the credentials with the whoami endpoint
+func (options *Options) CheckConnection() error {
+	if options.MatrixAccessToken == "" {
+		if err := options.login(); err != nil {
+			return err
+		}
+	}
+	return httpreq.NewClient().Ping(options.endpoint("account", "whoami"), options.headers())
+}
@@ -0,0 +1,103 @@
+package matrix
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// fakeHomeserver implements the subset of the client-server API used by the provider
+type fakeHomeserver struct {
+	logins int
+	joins  []string
+	events []MessageEvent
+}
+
+func (f *fakeHomeserver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, clientAPIPath)
+	switch {
+	case r.Method == http.MethodPost && path == "/login":
+		f.logins++
+		_ = json.NewEncoder(w).Encode(LoginResponse{AccessToken: "token"})
+		return
+	case r.Header.Get("Authorization") != "Bearer token":
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(APIError{ErrCode: "M_UNKNOWN_TOKEN", Error: "unknown token"})
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/join/"):
+		room := strings.TrimPrefix(path, "/join/")
+		f.joins = append(f.joins, room)
+		_ = json.NewEncoder(w).Encode(JoinResponse{RoomID: "!room:localhost"})
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "/rooms/!room:localhost/send/m.room.message/"):
+		var event MessageEvent
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		f.events = append(f.events, event)
+		_ = json.NewEncoder(w).Encode(SendResponse{EventID: "$event" + string(rune('0'+len(f.events)))})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(APIError{ErrCode: "M_UNRECOGNIZED"})
+	}
+}
+
+func TestSend(t *testing.T) {
+	homeserver := &fakeHomeserver{}
+	server := httptest.NewServer(homeserver)
+	defer server.Close()
+
+	provider, err := New([]*Options{{
+		ID:               "matrix",
+		MatrixHomeserver: server.URL,
+		MatrixUser:       "notify",
+		MatrixPassword:   "password",
+		MatrixRooms:      []string{"#alerts:localhost"},
+		MatrixHTML:       true,
+		MatrixThreads:    true,
+	}}, nil)
+	if err != nil {
+		t.Fatalf("could not create provider: %s", err)
+	}
+
+	for _, message := range []string{"<b>first</b>", "second"} {
+		if err := provider.Send(message, ""); err != nil {
+			t.Fatalf("could not send message: %s", err)
+		}
+	}
+
+	if homeserver.logins != 1 {
+		t.Errorf("expected a single login, got %d", homeserver.logins)
+	}
+	if len(homeserver.joins) != 1 || homeserver.joins[0] != "#alerts:localhost" {
+		t.Errorf("expected a single join of the room alias, got %v", homeserver.joins)
+	}
+	if len(homeserver.events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(homeserver.events))
+	}
+
+	first, second := homeserver.events[0], homeserver.events[1]
+	if first.Body != "first" || first.FormattedBody != "<b>first</b>" || first.Format != "org.matrix.custom.html" {
+		t.Errorf("unexpected formatted event: %+v", first)
+	}
+	if first.RelatesTo != nil {
+		t.Errorf("thread root should not relate to another event: %+v", first.RelatesTo)
+	}
+	if second.RelatesTo == nil || second.RelatesTo.RelType != "m.thread" || second.RelatesTo.EventID != "$event1" {
+		t.Errorf("expected second event to be threaded under the first, got %+v", second.RelatesTo)
+	}
+}
+
+func TestSendUnknownToken(t *testing.T) {
+	server := httptest.NewServer(&fakeHomeserver{})
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                "matrix",
+		MatrixHomeserver:  server.URL,
+		MatrixAccessToken: "invalid",
+		MatrixRooms:       []string{"!room:localhost"},
+	}}, nil)
+
+	if err := provider.Send("message", ""); err == nil {
+		t.Error("expected an error for an unknown access token")
+	}
+}
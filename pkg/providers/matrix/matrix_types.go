@@ -0,0 +1,51 @@
+package matrix
+
+type LoginRequest struct {
+	Type       string          `json:"type"`
+	Identifier LoginIdentifier `json:"identifier"`
+	Password   string          `json:"password"`
+}
+
+type LoginIdentifier struct {
+	Type string `json:"type"`
+	User string `json:"user"`
+}
+
+type LoginResponse struct {
+	APIError
+	AccessToken string `json:"access_token,omitempty"`
+}
+
+type JoinResponse struct {
+	APIError
+	RoomID string `json:"room_id,omitempty"`
+}
+
+type MessageEvent struct {
+	MsgType       string     `json:"msgtype"`
+	Body          string     `json:"body"`
+	Format        string     `json:"format,omitempty"`
+	FormattedBody string     `json:"formatted_body,omitempty"`
+	RelatesTo     *RelatesTo `json:"m.relates_to,omitempty"`
+}
+
+type RelatesTo struct {
+	RelType       string     `json:"rel_type,omitempty"`
+	EventID       string     `json:"event_id,omitempty"`
+	IsFallingBack bool       `json:"is_falling_back,omitempty"`
+	InReplyTo     *InReplyTo `json:"m.in_reply_to,omitempty"`
+}
+
+type InReplyTo struct {
+	EventID string `json:"event_id"`
+}
+
+type SendResponse struct {
+	APIError
+	EventID string `json:"event_id,omitempty"`
+}
+
+type APIError struct {
+	ErrCode string `json:"errcode,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
@@ -0,0 +1,205 @@
+package mqtt
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type Provider struct {
+	MQTT    []*Options `yaml:"mqtt,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID              string        `yaml:"id,omitempty"`
+	MQTTBrokerURL   string        `yaml:"mqtt_broker_url,omitempty"`
+	MQTTClientID    string        `yaml:"mqtt_client_id,omitempty"`
+	MQTTUsername    string        `yaml:"mqtt_username,omitempty"`
+	MQTTPassword    string        `yaml:"mqtt_password,omitempty"`
+	MQTTTopic       string        `yaml:"mqtt_topic,omitempty"`
+	MQTTQoS         byte          `yaml:"mqtt_qos,omitempty"`
+	MQTTRetain      bool          `yaml:"mqtt_retain,omitempty"`
+	MQTTTLSCA       string        `yaml:"mqtt_tls_ca,omitempty"`
+	MQTTTLSCert     string        `yaml:"mqtt_tls_cert,omitempty"`
+	MQTTTLSKey      string        `yaml:"mqtt_tls_key,omitempty"`
+	MQTTTLSInsecure bool          `yaml:"mqtt_tls_insecure,omitempty"`
+	MQTTTimeout     time.Duration `yaml:"mqtt_timeout,omitempty"`
+	MQTTFormat      string        `yaml:"mqtt_format,omitempty"`
+
+	client paho.Client
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.MQTTTimeout <= 0 {
+				o.MQTTTimeout = defaultTimeout
+			}
+			if o.MQTTClientID == "" {
+				o.MQTTClientID = fmt.Sprintf("notify-%d", time.Now().UnixNano())
+			}
+			provider.MQTT = append(provider.MQTT, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var MQTTErr error
+	p.counter++
+	for _, pr := range p.MQTT {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.MQTTFormat), p.counter)
+
+		if err := pr.publish(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send mqtt notification for id: %s ", pr.ID))
+			MQTTErr = multierr.Append(MQTTErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("mqtt notification sent for id: %s", pr.ID)
+	}
+	return MQTTErr
+}
+
+// DryRun writes the messages that would be published
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var MQTTErr error
+	p.counter++
+	for _, pr := range p.MQTT {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.MQTTFormat), p.counter)
+
+		topic, err := pr.topic(message, p.counter)
+		if err == nil {
+			payload := fmt.Sprintf("PUBLISH %s %s qos=%d retain=%t\n%s", pr.MQTTBrokerURL, topic, pr.MQTTQoS, pr.MQTTRetain, msg)
+			err = utils.WriteDryRun(w, pr.ID, payload)
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run mqtt notification for id: %s ", pr.ID))
+			MQTTErr = multierr.Append(MQTTErr, err)
+		}
+	}
+	return MQTTErr
+}
+
+func (options *Options) publish(msg, rawMessage string, counter int) error {
+	topic, err := options.topic(rawMessage, counter)
+	if err != nil {
+		return err
+	}
+
+	if options.client == nil {
+		if err := options.connect(); err != nil {
+			return err
+		}
+	}
+
+	token := options.client.Publish(topic, options.MQTTQoS, options.MQTTRetain, msg)
+	if !token.WaitTimeout(options.MQTTTimeout) {
+		return fmt.Errorf("timed out publishing to %s", topic)
+	}
+	return token.Error()
+}
+
+func (options *Options) connect() error {
+	tlsConfig, err := utils.NewTLSConfig(options.MQTTTLSCA, options.MQTTTLSCert, options.MQTTTLSKey, options.MQTTTLSInsecure)
+	if err != nil {
+		return err
+	}
+
+	clientOptions := paho.NewClientOptions().
+		AddBroker(options.MQTTBrokerURL).
+		SetClientID(options.MQTTClientID).
+		SetUsername(options.MQTTUsername).
+		SetPassword(options.MQTTPassword).
+		SetConnectTimeout(options.MQTTTimeout).
+		SetAutoReconnect(true)
+	if tlsConfig != nil {
+		clientOptions.SetTLSConfig(tlsConfig)
+	}
+
+	client := paho.NewClient(clientOptions)
+	token := client.Connect()
+	if !token.WaitTimeout(options.MQTTTimeout) {
+		return fmt.Errorf("timed out connecting to %s", options.MQTTBrokerURL)
+	}
+	if err := token.Error(); err != nil {
+		return err
+	}
+	options.client = client
+	return nil
+}
+
+// topic checks the options and returns the rendered topic
+func (options *Options) topic(rawMessage string, counter int) (string, error) {
+	if options.MQTTBrokerURL == "" {
+		return "", fmt.Errorf("mqtt_broker_url value is required")
+	}
+	if options.MQTTQoS > 2 {
+		return "", fmt.Errorf("invalid mqtt qos %d, expected 0, 1 or 2", options.MQTTQoS)
+	}
+	topic, err := utils.RenderTemplate(options.MQTTTopic, rawMessage, counter)
+	if err != nil {
+		return "", err
+	}
+	if topic = strings.TrimSpace(topic); topic == "" {
+		return "", fmt.Errorf("mqtt_topic value is required")
+	}
+	return topic, nil
+}
+
+// Close disconnects from the brokers once in-flight messages are delivered
+func (p *Provider) Close() error {
+	for _, pr := range p.MQTT {
+		if pr.client != nil {
+			pr.client.Disconnect(uint(pr.MQTTTimeout.Milliseconds()))
+			pr.client = nil
+		}
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("mqtt_broker_url", options.MQTTBrokerURL),
+		utils.ValidURL("mqtt_broker_url", options.MQTTBrokerURL, "tcp", "ssl", "tls", "mqtt", "mqtts", "ws", "wss"),
+		utils.Required("mqtt_topic", options.MQTTTopic),
+	)
+	if options.MQTTQoS > 2 {
+		err = multierr.Append(err, utils.NewFieldError("mqtt_qos", "expected 0, 1 or 2"))
+	}
+	if (options.MQTTTLSCert == "") != (options.MQTTTLSKey == "") {
+		err = multierr.Append(err, utils.NewFieldError("mqtt_tls_key", "mqtt_tls_cert and mqtt_tls_key must be set together"))
+	}
+	return err
+}
+
+// HealthCheck connects to the brokers without publishing
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.MQTT {
+		results = append(results, utils.CheckHealth(pr.ID, false, func() error {
+			if pr.client != nil {
+				return nil
+			}
+			return pr.connect()
+		}))
+	}
+	return results
+}
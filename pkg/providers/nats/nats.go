@@ -0,0 +1,211 @@
+package nats
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultTimeout = 10 * time.Second
+
+type Provider struct {
+	NATS    []*Options `yaml:"nats,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID              string        `yaml:"id,omitempty"`
+	NATSURL         string        `yaml:"nats_url,omitempty"`
+	NATSSubject     string        `yaml:"nats_subject,omitempty"`
+	NATSJetStream   bool          `yaml:"nats_jetstream,omitempty"`
+	NATSCredsFile   string        `yaml:"nats_creds_file,omitempty"`
+	NATSToken       string        `yaml:"nats_token,omitempty"`
+	NATSUsername    string        `yaml:"nats_username,omitempty"`
+	NATSPassword    string        `yaml:"nats_password,omitempty"`
+	NATSTLSCA       string        `yaml:"nats_tls_ca,omitempty"`
+	NATSTLSCert     string        `yaml:"nats_tls_cert,omitempty"`
+	NATSTLSKey      string        `yaml:"nats_tls_key,omitempty"`
+	NATSTLSInsecure bool          `yaml:"nats_tls_insecure,omitempty"`
+	NATSTimeout     time.Duration `yaml:"nats_timeout,omitempty"`
+	NATSFormat      string        `yaml:"nats_format,omitempty"`
+
+	conn      *natsgo.Conn
+	jetStream natsgo.JetStreamContext
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.NATSURL == "" {
+				o.NATSURL = natsgo.DefaultURL
+			}
+			if o.NATSTimeout <= 0 {
+				o.NATSTimeout = defaultTimeout
+			}
+			provider.NATS = append(provider.NATS, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var NATSErr error
+	p.counter++
+	for _, pr := range p.NATS {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.NATSFormat), p.counter)
+
+		if err := pr.publish(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send nats notification for id: %s ", pr.ID))
+			NATSErr = multierr.Append(NATSErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("nats notification sent for id: %s", pr.ID)
+	}
+	return NATSErr
+}
+
+// DryRun writes the messages that would be published
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var NATSErr error
+	p.counter++
+	for _, pr := range p.NATS {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.NATSFormat), p.counter)
+
+		subject, err := pr.subject(message, p.counter)
+		if err == nil {
+			payload := fmt.Sprintf("PUB %s %s jetstream=%t\n%s", pr.NATSURL, subject, pr.NATSJetStream, msg)
+			err = utils.WriteDryRun(w, pr.ID, payload)
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run nats notification for id: %s ", pr.ID))
+			NATSErr = multierr.Append(NATSErr, err)
+		}
+	}
+	return NATSErr
+}
+
+func (options *Options) publish(msg, rawMessage string, counter int) error {
+	subject, err := options.subject(rawMessage, counter)
+	if err != nil {
+		return err
+	}
+
+	if options.conn == nil {
+		if err := options.connect(); err != nil {
+			return err
+		}
+	}
+
+	if options.NATSJetStream {
+		// waits for the stream to acknowledge the message
+		_, err = options.jetStream.Publish(subject, []byte(msg), natsgo.AckWait(options.NATSTimeout))
+		return err
+	}
+	return options.conn.Publish(subject, []byte(msg))
+}
+
+func (options *Options) connect() error {
+	connOptions := []natsgo.Option{
+		natsgo.Name("notify"),
+		natsgo.Timeout(options.NATSTimeout),
+	}
+	switch {
+	case options.NATSCredsFile != "":
+		connOptions = append(connOptions, natsgo.UserCredentials(options.NATSCredsFile))
+	case options.NATSToken != "":
+		connOptions = append(connOptions, natsgo.Token(options.NATSToken))
+	case options.NATSUsername != "":
+		connOptions = append(connOptions, natsgo.UserInfo(options.NATSUsername, options.NATSPassword))
+	}
+
+	tlsConfig, err := utils.NewTLSConfig(options.NATSTLSCA, options.NATSTLSCert, options.NATSTLSKey, options.NATSTLSInsecure)
+	if err != nil {
+		return err
+	}
+	if tlsConfig != nil {
+		connOptions = append(connOptions, natsgo.Secure(tlsConfig))
+	}
+
+	conn, err := natsgo.Connect(options.NATSURL, connOptions...)
+	if err != nil {
+		return err
+	}
+	if options.NATSJetStream {
+		jetStream, err := conn.JetStream(natsgo.MaxWait(options.NATSTimeout))
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		options.jetStream = jetStream
+	}
+	options.conn = conn
+	return nil
+}
+
+// subject returns the rendered subject
+func (options *Options) subject(rawMessage string, counter int) (string, error) {
+	subject, err := utils.RenderTemplate(options.NATSSubject, rawMessage, counter)
+	if err != nil {
+		return "", err
+	}
+	if subject = strings.TrimSpace(subject); subject == "" {
+		return "", fmt.Errorf("nats_subject value is required")
+	}
+	return subject, nil
+}
+
+// Close flushes pending messages and closes the connections
+func (p *Provider) Close() error {
+	var NATSErr error
+	for _, pr := range p.NATS {
+		if pr.conn != nil {
+			NATSErr = multierr.Append(NATSErr, pr.conn.FlushTimeout(pr.NATSTimeout))
+			pr.conn.Close()
+			pr.conn = nil
+		}
+	}
+	return NATSErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := utils.Required("nats_subject", options.NATSSubject)
+	// nats_url may hold a comma separated list of servers
+	for _, server := range strings.Split(options.NATSURL, ",") {
+		err = multierr.Append(err, utils.ValidURL("nats_url", strings.TrimSpace(server), "nats", "tls", "ws", "wss"))
+	}
+	if (options.NATSTLSCert == "") != (options.NATSTLSKey == "") {
+		err = multierr.Append(err, utils.NewFieldError("nats_tls_key", "nats_tls_cert and nats_tls_key must be set together"))
+	}
+	return err
+}
+
+// HealthCheck connects to the servers without publishing
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.NATS {
+		results = append(results, utils.CheckHealth(pr.ID, false, func() error {
+			if pr.conn == nil {
+				if err := pr.connect(); err != nil {
+					return err
+				}
+			}
+			return pr.conn.FlushTimeout(pr.NATSTimeout)
+		}))
+	}
+	return results
+}
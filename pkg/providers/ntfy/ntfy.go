@@ -0,0 +1,98 @@
+package ntfy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultServerURL = "https://ntfy.sh"
+
+type Provider struct {
+	Ntfy    []*Options `yaml:"ntfy,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID              string    `yaml:"id,omitempty"`
+	NtfyServerURL   string    `yaml:"ntfy_server_url,omitempty"`
+	NtfyTopic       string    `yaml:"ntfy_topic,omitempty"`
+	NtfyAccessToken string    `yaml:"ntfy_access_token,omitempty"`
+	NtfyUsername    string    `yaml:"ntfy_username,omitempty"`
+	NtfyPassword    string    `yaml:"ntfy_password,omitempty"`
+	NtfyTitle       string    `yaml:"ntfy_title,omitempty"`
+	NtfyPriority    string    `yaml:"ntfy_priority,omitempty"`
+	NtfyTags        []string  `yaml:"ntfy_tags,omitempty"`
+	NtfyClick       string    `yaml:"ntfy_click,omitempty"`
+	NtfyAttach      string    `yaml:"ntfy_attach,omitempty"`
+	NtfyFilename    string    `yaml:"ntfy_filename,omitempty"`
+	NtfyActions     []*Action `yaml:"ntfy_actions,omitempty"`
+	NtfyFormat      string    `yaml:"ntfy_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.NtfyServerURL == "" {
+				o.NtfyServerURL = defaultServerURL
+			}
+			provider.Ntfy = append(provider.Ntfy, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var NtfyErr error
+	p.counter++
+	for _, pr := range p.Ntfy {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.NtfyFormat), p.counter)
+
+		if pr.NtfyTopic == "" {
+			err := errors.Wrap(fmt.Errorf("ntfy_topic value is required"),
+				fmt.Sprintf("failed to send ntfy notification for id: %s ", pr.ID))
+			NtfyErr = multierr.Append(NtfyErr, err)
+			continue
+		}
+
+		payload, err := pr.buildRequest(msg, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render ntfy template for id: %s ", pr.ID))
+			NtfyErr = multierr.Append(NtfyErr, err)
+			continue
+		}
+
+		if err := pr.SendMessage(payload); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send ntfy notification for id: %s ", pr.ID))
+			NtfyErr = multierr.Append(NtfyErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("ntfy notification sent for id: %s", pr.ID)
+	}
+	return NtfyErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.ValidURL("ntfy_server_url", options.NtfyServerURL),
+		utils.Required("ntfy_topic", options.NtfyTopic),
+	)
+	if !strings.Contains(options.NtfyPriority, "{{") {
+		if _, priorityErr := parsePriority(options.NtfyPriority); priorityErr != nil {
+			err = multierr.Append(err, utils.NewFieldError("ntfy_priority", "%v", priorityErr))
+		}
+	}
+	return err
+}
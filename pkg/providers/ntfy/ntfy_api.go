@@ -0,0 +1,105 @@
+package ntfy
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+var priorities = map[string]int{
+	"min":     1,
+	"low":     2,
+	"default": 3,
+	"high":    4,
+	"max":     5,
+	"urgent":  5,
+}
+
+// parsePriority accepts ntfy priorities either as a number (1-5) or by name
+func parsePriority(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, nil
+	}
+	if priority, ok := priorities[value]; ok {
+		return priority, nil
+	}
+	priority, err := strconv.Atoi(value)
+	if err != nil || priority < 1 || priority > 5 {
+		return 0, fmt.Errorf("invalid ntfy priority %q", value)
+	}
+	return priority, nil
+}
+
+// buildRequest renders the templated fields of the options for the given message
+func (options *Options) buildRequest(message, rawMessage string, counter int) (*APIRequest, error) {
+	render := func(text string) (string, error) {
+		return utils.RenderTemplate(text, rawMessage, counter)
+	}
+
+	payload := &APIRequest{Message: message, Actions: options.NtfyActions}
+
+	var err error
+	if payload.Topic, err = render(options.NtfyTopic); err != nil {
+		return nil, err
+	}
+	if payload.Title, err = render(options.NtfyTitle); err != nil {
+		return nil, err
+	}
+	if payload.Click, err = render(options.NtfyClick); err != nil {
+		return nil, err
+	}
+	if payload.Attach, err = render(options.NtfyAttach); err != nil {
+		return nil, err
+	}
+	payload.Filename = options.NtfyFilename
+
+	priority, err := render(options.NtfyPriority)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Priority, err = parsePriority(priority); err != nil {
+		return nil, err
+	}
+
+	for _, tag := range options.NtfyTags {
+		rendered, err := render(tag)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range strings.Split(rendered, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				payload.Tags = append(payload.Tags, t)
+			}
+		}
+	}
+	return payload, nil
+}
+
+func (options *Options) SendMessage(payload *APIRequest) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+	switch {
+	case options.NtfyAccessToken != "":
+		headers.Set("Authorization", fmt.Sprintf("Bearer %s", options.NtfyAccessToken))
+	case options.NtfyUsername != "":
+		credentials := base64.StdEncoding.EncodeToString([]byte(options.NtfyUsername + ":" + options.NtfyPassword))
+		headers.Set("Authorization", fmt.Sprintf("Basic %s", credentials))
+	}
+
+	var response *APIResponse
+	err := httpreq.NewClient().Post(strings.TrimSuffix(options.NtfyServerURL, "/"), payload, headers, &response)
+	if err != nil {
+		return err
+	}
+	if response.Error != "" {
+		return fmt.Errorf("error while sending ntfy message: %d %s", response.Code, response.Error)
+	}
+	return nil
+}
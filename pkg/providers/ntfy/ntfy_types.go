@@ -0,0 +1,29 @@
+package ntfy
+
+type APIRequest struct {
+	Topic    string    `json:"topic"`
+	Message  string    `json:"message,omitempty"`
+	Title    string    `json:"title,omitempty"`
+	Tags     []string  `json:"tags,omitempty"`
+	Priority int       `json:"priority,omitempty"`
+	Click    string    `json:"click,omitempty"`
+	Attach   string    `json:"attach,omitempty"`
+	Filename string    `json:"filename,omitempty"`
+	Actions  []*Action `json:"actions,omitempty"`
+}
+
+type Action struct {
+	Action  string            `yaml:"action,omitempty" json:"action"`
+	Label   string            `yaml:"label,omitempty" json:"label"`
+	URL     string            `yaml:"url,omitempty" json:"url,omitempty"`
+	Method  string            `yaml:"method,omitempty" json:"method,omitempty"`
+	Headers map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
+	Body    string            `yaml:"body,omitempty" json:"body,omitempty"`
+	Clear   bool              `yaml:"clear,omitempty" json:"clear,omitempty"`
+}
+
+type APIResponse struct {
+	ID    string `json:"id,omitempty"`
+	Code  int    `json:"code,omitempty"`
+	Error string `json:"error,omitempty"`
+}
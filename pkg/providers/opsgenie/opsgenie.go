@@ -0,0 +1,115 @@
+package opsgenie
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	Opsgenie []*Options `yaml:"opsgenie,omitempty"`
+	counter  int
+}
+
+type Options struct {
+	ID                 string            `yaml:"id,omitempty"`
+	OpsgenieAPIKey     string            `yaml:"opsgenie_api_key,omitempty"`
+	OpsgenieRegion     string            `yaml:"opsgenie_region,omitempty"`
+	OpsgenieBaseURL    string            `yaml:"opsgenie_base_url,omitempty"`
+	OpsgenieAction     string            `yaml:"opsgenie_action,omitempty"`
+	OpsgenieMessage    string            `yaml:"opsgenie_message,omitempty"`
+	OpsgenieAlias      string            `yaml:"opsgenie_alias,omitempty"`
+	OpsgeniePriority   string            `yaml:"opsgenie_priority,omitempty"`
+	OpsgenieResponders []*Responder      `yaml:"opsgenie_responders,omitempty"`
+	OpsgenieTags       []string          `yaml:"opsgenie_tags,omitempty"`
+	OpsgenieEntity     string            `yaml:"opsgenie_entity,omitempty"`
+	OpsgenieDetails    map[string]string `yaml:"opsgenie_details,omitempty"`
+	OpsgenieFormat     string            `yaml:"opsgenie_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.Opsgenie = append(provider.Opsgenie, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var OpsgenieErr error
+	p.counter++
+	for _, pr := range p.Opsgenie {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.OpsgenieFormat), p.counter)
+
+		if pr.OpsgenieAPIKey == "" {
+			err := errors.Wrap(fmt.Errorf("opsgenie_api_key value is required"),
+				fmt.Sprintf("failed to send opsgenie notification for id: %s ", pr.ID))
+			OpsgenieErr = multierr.Append(OpsgenieErr, err)
+			continue
+		}
+
+		action, err := utils.RenderTemplate(pr.OpsgenieAction, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render opsgenie action for id: %s ", pr.ID))
+			OpsgenieErr = multierr.Append(OpsgenieErr, err)
+			continue
+		}
+
+		switch strings.ToLower(strings.TrimSpace(action)) {
+		case "", "create":
+			alert, err := pr.buildAlert(msg, message, p.counter)
+			if err != nil {
+				err = errors.Wrap(err, fmt.Sprintf("failed to build opsgenie alert for id: %s ", pr.ID))
+				OpsgenieErr = multierr.Append(OpsgenieErr, err)
+				continue
+			}
+			err = pr.CreateAlert(alert)
+		case "close":
+			var alias string
+			alias, err = utils.RenderTemplate(pr.OpsgenieAlias, message, p.counter)
+			if err == nil && strings.TrimSpace(alias) == "" {
+				err = fmt.Errorf("opsgenie_alias value is required to close an alert")
+			}
+			if err == nil {
+				err = pr.CloseAlert(strings.TrimSpace(alias), msg)
+			}
+		default:
+			err = fmt.Errorf("invalid opsgenie action %q", action)
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send opsgenie notification for id: %s ", pr.ID))
+			OpsgenieErr = multierr.Append(OpsgenieErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("opsgenie notification sent for id: %s", pr.ID)
+	}
+	return OpsgenieErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("opsgenie_api_key", options.OpsgenieAPIKey),
+		utils.OneOf("opsgenie_region", options.OpsgenieRegion, "us", "eu"),
+		utils.ValidURL("opsgenie_base_url", options.OpsgenieBaseURL),
+		utils.OneOf("opsgenie_action", options.OpsgenieAction, "create", "close"),
+	)
+	if options.OpsgeniePriority != "" && !strings.Contains(options.OpsgeniePriority, "{{") {
+		if _, priorityErr := parsePriority(options.OpsgeniePriority); priorityErr != nil {
+			err = multierr.Append(err, utils.NewFieldError("opsgenie_priority", "%v", priorityErr))
+		}
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+package opsgenie
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const (
+	usAPIURL = "https://api.opsgenie.com"
+	euAPIURL = "https://api.eu.opsgenie.com"
+
+	// maxMessageLength is the maximum alert message length accepted by the Alerts API
+	maxMessageLength = 130
+)
+
+// severityPriorities maps common finding severities to opsgenie priorities
+var severityPriorities = map[string]string{
+	"critical": "P1",
+	"high":     "P2",
+	"medium":   "P3",
+	"low":      "P4",
+	"info":     "P5",
+}
+
+func parsePriority(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if priority, ok := severityPriorities[strings.ToLower(value)]; ok {
+		return priority, nil
+	}
+	switch priority := strings.ToUpper(value); priority {
+	case "P1", "P2", "P3", "P4", "P5":
+		return priority, nil
+	}
+	return "", fmt.Errorf("invalid opsgenie priority %q", value)
+}
+
+func (options *Options) baseURL() string {
+	switch {
+	case options.OpsgenieBaseURL != "":
+		return strings.TrimSuffix(options.OpsgenieBaseURL, "/")
+	case strings.EqualFold(options.OpsgenieRegion, "eu"):
+		return euAPIURL
+	default:
+		return usAPIURL
+	}
+}
+
+func (options *Options) headers() http.Header {
+	return http.Header{
+		"Content-Type":  {"application/json"},
+		"Authorization": {fmt.Sprintf("GenieKey %s", options.OpsgenieAPIKey)},
+	}
+}
+
+// buildAlert renders the templated fields of the options for the given message
+func (options *Options) buildAlert(message, rawMessage string, counter int) (*CreateAlertRequest, error) {
+	render := func(text string) (string, error) {
+		rendered, err := utils.RenderTemplate(text, rawMessage, counter)
+		return strings.TrimSpace(rendered), err
+	}
+
+	alert := &CreateAlertRequest{
+		Description: message,
+		Responders:  options.OpsgenieResponders,
+		Source:      "notify",
+	}
+
+	var err error
+	if alert.Message, err = render(options.OpsgenieMessage); err != nil {
+		return nil, err
+	}
+	if alert.Message == "" {
+		alert.Message = strings.SplitN(strings.TrimSpace(message), "\n", 2)[0]
+	}
+	alert.Message = utils.Truncate(alert.Message, maxMessageLength)
+	if alert.Alias, err = render(options.OpsgenieAlias); err != nil {
+		return nil, err
+	}
+	if alert.Entity, err = render(options.OpsgenieEntity); err != nil {
+		return nil, err
+	}
+	priority, err := render(options.OpsgeniePriority)
+	if err != nil {
+		return nil, err
+	}
+	if alert.Priority, err = parsePriority(priority); err != nil {
+		return nil, err
+	}
+	for _, tag := range options.OpsgenieTags {
+		rendered, err := render(tag)
+		if err != nil {
+			return nil, err
+		}
+		if rendered != "" {
+			alert.Tags = append(alert.Tags, rendered)
+		}
+	}
+	if len(options.OpsgenieDetails) > 0 {
+		alert.Details = make(map[string]string, len(options.OpsgenieDetails))
+		for key, value := range options.OpsgenieDetails {
+			if alert.Details[key], err = render(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return alert, nil
+}
+
+func (options *Options) post(url string, payload interface{}) error {
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(url, payload, options.headers(), &response); err != nil {
+		return err
+	}
+	if response.Result == "" {
+		return fmt.Errorf("error while sending opsgenie request: %s", response.Message)
+	}
+	return nil
+}
+
+func (options *Options) CreateAlert(alert *CreateAlertRequest) error {
+	return options.post(options.baseURL()+"/v2/alerts", alert)
+}
+
+func (options *Options) CloseAlert(alias, note string) error {
+	closeURL := fmt.Sprintf("%s/v2/alerts/%s/close?identifierType=alias", options.baseURL(), url.PathEscape(alias))
+	return options.post(closeURL, &CloseAlertRequest{Source: "notify", Note: note})
+}
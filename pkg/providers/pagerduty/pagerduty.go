@@ -0,0 +1,91 @@
+package pagerduty
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+type Provider struct {
+	PagerDuty []*Options `yaml:"pagerduty,omitempty"`
+	counter   int
+}
+
+type Options struct {
+	ID                     string            `yaml:"id,omitempty"`
+	PagerDutyRoutingKey    string            `yaml:"pagerduty_routing_key,omitempty"`
+	PagerDutyURL           string            `yaml:"pagerduty_url,omitempty"`
+	PagerDutyAction        string            `yaml:"pagerduty_action,omitempty"`
+	PagerDutySeverity      string            `yaml:"pagerduty_severity,omitempty"`
+	PagerDutySource        string            `yaml:"pagerduty_source,omitempty"`
+	PagerDutyComponent     string            `yaml:"pagerduty_component,omitempty"`
+	PagerDutyGroup         string            `yaml:"pagerduty_group,omitempty"`
+	PagerDutyClass         string            `yaml:"pagerduty_class,omitempty"`
+	PagerDutyDedupKey      string            `yaml:"pagerduty_dedup_key,omitempty"`
+	PagerDutyCustomDetails map[string]string `yaml:"pagerduty_custom_details,omitempty"`
+	PagerDutyFormat        string            `yaml:"pagerduty_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.PagerDutyURL == "" {
+				o.PagerDutyURL = defaultEventsURL
+			}
+			provider.PagerDuty = append(provider.PagerDuty, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var PagerDutyErr error
+	p.counter++
+	for _, pr := range p.PagerDuty {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.PagerDutyFormat), p.counter)
+
+		if pr.PagerDutyRoutingKey == "" {
+			err := errors.Wrap(fmt.Errorf("pagerduty_routing_key value is required"),
+				fmt.Sprintf("failed to send pagerduty notification for id: %s ", pr.ID))
+			PagerDutyErr = multierr.Append(PagerDutyErr, err)
+			continue
+		}
+
+		event, err := pr.buildEvent(msg, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build pagerduty event for id: %s ", pr.ID))
+			PagerDutyErr = multierr.Append(PagerDutyErr, err)
+			continue
+		}
+
+		if err := pr.SendEvent(event); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send pagerduty notification for id: %s ", pr.ID))
+			PagerDutyErr = multierr.Append(PagerDutyErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("pagerduty notification sent for id: %s", pr.ID)
+	}
+	return PagerDutyErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("pagerduty_routing_key", options.PagerDutyRoutingKey),
+		utils.ValidURL("pagerduty_url", options.PagerDutyURL),
+		utils.OneOf("pagerduty_action", options.PagerDutyAction, "trigger", "acknowledge", "resolve"),
+		utils.OneOf("pagerduty_severity", options.PagerDutySeverity, severities...),
+	)
+}
@@ -0,0 +1,106 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	sliceutil "github.com/projectdiscovery/utils/slice"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// maxSummaryLength is the maximum summary length accepted by the Events API
+const maxSummaryLength = 1024
+
+var (
+	eventActions = []string{"trigger", "acknowledge", "resolve"}
+	severities   = []string{"critical", "error", "warning", "info"}
+)
+
+// buildEvent renders the templated fields of the options for the given message
+func (options *Options) buildEvent(message, rawMessage string, counter int) (*Event, error) {
+	render := func(text string) (string, error) {
+		rendered, err := utils.RenderTemplate(text, rawMessage, counter)
+		return strings.TrimSpace(rendered), err
+	}
+
+	event := &Event{RoutingKey: options.PagerDutyRoutingKey, Client: "notify"}
+
+	var err error
+	if event.EventAction, err = render(options.PagerDutyAction); err != nil {
+		return nil, err
+	}
+	// the Events API only accepts lowercase actions and severities
+	event.EventAction = strings.ToLower(event.EventAction)
+	if event.EventAction == "" {
+		event.EventAction = "trigger"
+	}
+	if !sliceutil.Contains(eventActions, event.EventAction) {
+		return nil, fmt.Errorf("invalid pagerduty event action %q", event.EventAction)
+	}
+	if event.DedupKey, err = render(options.PagerDutyDedupKey); err != nil {
+		return nil, err
+	}
+	if event.EventAction != "trigger" {
+		if event.DedupKey == "" {
+			return nil, fmt.Errorf("pagerduty_dedup_key value is required to %s an incident", event.EventAction)
+		}
+		return event, nil
+	}
+
+	message = utils.Truncate(message, maxSummaryLength)
+	payload := &EventPayload{Summary: message}
+	if payload.Severity, err = render(options.PagerDutySeverity); err != nil {
+		return nil, err
+	}
+	payload.Severity = strings.ToLower(payload.Severity)
+	if payload.Severity == "" {
+		payload.Severity = "error"
+	}
+	if !sliceutil.Contains(severities, payload.Severity) {
+		return nil, fmt.Errorf("invalid pagerduty severity %q", payload.Severity)
+	}
+	if payload.Source, err = render(options.PagerDutySource); err != nil {
+		return nil, err
+	}
+	if payload.Source == "" {
+		payload.Source = "notify"
+	}
+	if payload.Component, err = render(options.PagerDutyComponent); err != nil {
+		return nil, err
+	}
+	if payload.Group, err = render(options.PagerDutyGroup); err != nil {
+		return nil, err
+	}
+	if payload.Class, err = render(options.PagerDutyClass); err != nil {
+		return nil, err
+	}
+	if len(options.PagerDutyCustomDetails) > 0 {
+		payload.CustomDetails = make(map[string]string, len(options.PagerDutyCustomDetails))
+		for key, value := range options.PagerDutyCustomDetails {
+			if payload.CustomDetails[key], err = render(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	event.Payload = payload
+	return event, nil
+}
+
+func (options *Options) SendEvent(event *Event) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+
+	var response *EventResponse
+	err := httpreq.NewClient().Post(options.PagerDutyURL, event, headers, &response)
+	if err != nil {
+		return err
+	}
+	if response.Status != "success" {
+		return fmt.Errorf("error while sending pagerduty event: %s %s", response.Message, strings.Join(response.Errors, ", "))
+	}
+	return nil
+}
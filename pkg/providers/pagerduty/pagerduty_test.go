@@ -0,0 +1,89 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	var events []*Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event *Event
+		_ = json.NewDecoder(r.Body).Decode(&event)
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(EventResponse{Status: "success", DedupKey: event.DedupKey})
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                  "pagerduty",
+		PagerDutyRoutingKey: "routing-key",
+		PagerDutyURL:        server.URL,
+		PagerDutyAction:     `{{ if .resolved }}resolve{{ else }}trigger{{ end }}`,
+		PagerDutySeverity:   `{{ .info.severity }}`,
+		PagerDutyDedupKey:   `{{ index . "template-id" }}-{{ .host }}`,
+		PagerDutyCustomDetails: map[string]string{
+			"matched": "{{ .matched }}",
+		},
+	}}, nil)
+
+	for _, message := range []string{
+		`{"host":"example.com","template-id":"exposed-panel","matched":"/admin","info":{"severity":"critical"}}`,
+		`{"host":"example.com","template-id":"exposed-panel","resolved":true}`,
+	} {
+		if err := provider.Send(message, ""); err != nil {
+			t.Fatalf("could not send event: %s", err)
+		}
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(events))
+	}
+	trigger, resolve := events[0], events[1]
+	if trigger.EventAction != "trigger" || trigger.Payload == nil || trigger.Payload.Severity != "critical" || trigger.Payload.Source != "notify" {
+		t.Errorf("unexpected trigger event: %+v", trigger)
+	}
+	if trigger.Payload.CustomDetails["matched"] != "/admin" {
+		t.Errorf("unexpected custom details: %v", trigger.Payload.CustomDetails)
+	}
+	if resolve.EventAction != "resolve" || resolve.Payload != nil {
+		t.Errorf("unexpected resolve event: %+v", resolve)
+	}
+	if trigger.DedupKey == "" || trigger.DedupKey != resolve.DedupKey {
+		t.Errorf("expected matching dedup keys, got %q and %q", trigger.DedupKey, resolve.DedupKey)
+	}
+}
+
+func TestSendInvalidSeverity(t *testing.T) {
+	provider, _ := New([]*Options{{
+		ID:                  "pagerduty",
+		PagerDutyRoutingKey: "routing-key",
+		PagerDutyURL:        "http://127.0.0.1:0",
+		PagerDutySeverity:   "urgent",
+	}}, nil)
+
+	if err := provider.Send("message", ""); err == nil {
+		t.Error("expected an error for an invalid severity")
+	}
+}
+
+func TestBuildEventCase(t *testing.T) {
+	options := &Options{
+		PagerDutyRoutingKey: "routing-key",
+		PagerDutyAction:     "Trigger",
+		PagerDutySeverity:   "{{ .severity }}",
+	}
+	if err := options.Validate(); err != nil {
+		t.Fatalf("expected mixed case values to be valid, got %s", err)
+	}
+	event, err := options.buildEvent("message", `{"severity":"Critical"}`, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.EventAction != "trigger" || event.Payload.Severity != "critical" {
+		t.Errorf("expected lowercase action and severity, got %q and %q", event.EventAction, event.Payload.Severity)
+	}
+}
@@ -0,0 +1,26 @@
+package pagerduty
+
+type Event struct {
+	RoutingKey  string        `json:"routing_key"`
+	EventAction string        `json:"event_action"`
+	DedupKey    string        `json:"dedup_key,omitempty"`
+	Client      string        `json:"client,omitempty"`
+	Payload     *EventPayload `json:"payload,omitempty"`
+}
+
+type EventPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	Component     string            `json:"component,omitempty"`
+	Group         string            `json:"group,omitempty"`
+	Class         string            `json:"class,omitempty"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type EventResponse struct {
+	Status   string   `json:"status,omitempty"`
+	Message  string   `json:"message,omitempty"`
+	DedupKey string   `json:"dedup_key,omitempty"`
+	Errors   []string `json:"errors,omitempty"`
+}
@@ -1,35 +1,95 @@
 package providers
 
 import (
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/acarl005/stripansi"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
 	"github.com/projectdiscovery/gologger"
 	"github.com/projectdiscovery/notify/pkg/providers/custom"
+	"github.com/projectdiscovery/notify/pkg/providers/dingtalk"
 	"github.com/projectdiscovery/notify/pkg/providers/discord"
+	"github.com/projectdiscovery/notify/pkg/providers/elasticsearch"
+	"github.com/projectdiscovery/notify/pkg/providers/exec"
+	"github.com/projectdiscovery/notify/pkg/providers/feishu"
+	"github.com/projectdiscovery/notify/pkg/providers/file"
+	"github.com/projectdiscovery/notify/pkg/providers/github"
+	"github.com/projectdiscovery/notify/pkg/providers/gitlab"
 	"github.com/projectdiscovery/notify/pkg/providers/googlechat"
 	"github.com/projectdiscovery/notify/pkg/providers/gotify"
+	"github.com/projectdiscovery/notify/pkg/providers/jira"
+	"github.com/projectdiscovery/notify/pkg/providers/loki"
+	"github.com/projectdiscovery/notify/pkg/providers/matrix"
+	"github.com/projectdiscovery/notify/pkg/providers/mqtt"
+	"github.com/projectdiscovery/notify/pkg/providers/nats"
+	"github.com/projectdiscovery/notify/pkg/providers/ntfy"
+	"github.com/projectdiscovery/notify/pkg/providers/opsgenie"
+	"github.com/projectdiscovery/notify/pkg/providers/pagerduty"
 	"github.com/projectdiscovery/notify/pkg/providers/pushover"
+	"github.com/projectdiscovery/notify/pkg/providers/rocketchat"
+	"github.com/projectdiscovery/notify/pkg/providers/shoutrrr"
 	"github.com/projectdiscovery/notify/pkg/providers/slack"
+	"github.com/projectdiscovery/notify/pkg/providers/sms"
 	"github.com/projectdiscovery/notify/pkg/providers/smtp"
+	"github.com/projectdiscovery/notify/pkg/providers/splunk"
+	"github.com/projectdiscovery/notify/pkg/providers/stdout"
+	"github.com/projectdiscovery/notify/pkg/providers/syslog"
 	"github.com/projectdiscovery/notify/pkg/providers/teams"
 	"github.com/projectdiscovery/notify/pkg/providers/telegram"
+	"github.com/projectdiscovery/notify/pkg/providers/webex"
+	"github.com/projectdiscovery/notify/pkg/providers/wecom"
+	"github.com/projectdiscovery/notify/pkg/providers/zulip"
 	"github.com/projectdiscovery/notify/pkg/types"
+	"github.com/projectdiscovery/notify/pkg/utils"
 	sliceutil "github.com/projectdiscovery/utils/slice"
 )
 
 // ProviderOptions is configuration for notify providers
 type ProviderOptions struct {
-	Slack      []*slack.Options      `yaml:"slack,omitempty"`
-	Discord    []*discord.Options    `yaml:"discord,omitempty"`
-	Pushover   []*pushover.Options   `yaml:"pushover,omitempty"`
-	SMTP       []*smtp.Options       `yaml:"smtp,omitempty"`
-	Teams      []*teams.Options      `yaml:"teams,omitempty"`
-	Telegram   []*telegram.Options   `yaml:"telegram,omitempty"`
-	GoogleChat []*googlechat.Options `yaml:"googlechat,omitempty"`
-	Custom     []*custom.Options     `yaml:"custom,omitempty"`
-	Gotify     []*gotify.Options     `yaml:"gotify,omitempty"`
+	Slack         []*slack.Options         `yaml:"slack,omitempty"`
+	Discord       []*discord.Options       `yaml:"discord,omitempty"`
+	Pushover      []*pushover.Options      `yaml:"pushover,omitempty"`
+	SMTP          []*smtp.Options          `yaml:"smtp,omitempty"`
+	Teams         []*teams.Options         `yaml:"teams,omitempty"`
+	Telegram      []*telegram.Options      `yaml:"telegram,omitempty"`
+	GoogleChat    []*googlechat.Options    `yaml:"googlechat,omitempty"`
+	Custom        []*custom.Options        `yaml:"custom,omitempty"`
+	Gotify        []*gotify.Options        `yaml:"gotify,omitempty"`
+	Matrix        []*matrix.Options        `yaml:"matrix,omitempty"`
+	Ntfy          []*ntfy.Options          `yaml:"ntfy,omitempty"`
+	PagerDuty     []*pagerduty.Options     `yaml:"pagerduty,omitempty"`
+	Opsgenie      []*opsgenie.Options      `yaml:"opsgenie,omitempty"`
+	Jira          []*jira.Options          `yaml:"jira,omitempty"`
+	GithubIssues  []*github.Options        `yaml:"github_issues,omitempty"`
+	GitlabIssues  []*gitlab.Options        `yaml:"gitlab_issues,omitempty"`
+	RocketChat    []*rocketchat.Options    `yaml:"rocketchat,omitempty"`
+	Zulip         []*zulip.Options         `yaml:"zulip,omitempty"`
+	Webex         []*webex.Options         `yaml:"webex,omitempty"`
+	DingTalk      []*dingtalk.Options      `yaml:"dingtalk,omitempty"`
+	Feishu        []*feishu.Options        `yaml:"feishu,omitempty"`
+	WeCom         []*wecom.Options         `yaml:"wecom,omitempty"`
+	Splunk        []*splunk.Options        `yaml:"splunk,omitempty"`
+	Elasticsearch []*elasticsearch.Options `yaml:"elasticsearch,omitempty"`
+	Loki          []*loki.Options          `yaml:"loki,omitempty"`
+	File          []*file.Options          `yaml:"file,omitempty"`
+	Exec          []*exec.Options          `yaml:"exec,omitempty"`
+	Stdout        []*stdout.Options        `yaml:"stdout,omitempty"`
+	Syslog        []*syslog.Options        `yaml:"syslog,omitempty"`
+	Mqtt          []*mqtt.Options          `yaml:"mqtt,omitempty"`
+	Nats          []*nats.Options          `yaml:"nats,omitempty"`
+	SMS           []*sms.Options           `yaml:"sms,omitempty"`
+	Shoutrrr      []*shoutrrr.Options      `yaml:"shoutrrr,omitempty"`
+
+	// Include lists the config files, as globs, merged before this one
+	Include []string `yaml:"include,omitempty"`
+	// Profiles are named selections of ids and options chosen with -profile
+	Profiles map[string]*Profile `yaml:"profiles,omitempty"`
+	// RateLimits replace the default rate limits of the providers
+	RateLimits map[string]*ProviderRateLimit `yaml:"rate_limits,omitempty"`
 }
 
 // Provider is an interface implemented by providers
@@ -38,14 +98,23 @@ type Provider interface {
 }
 
 type Client struct {
-	providers       []Provider
+	providers []Provider
+	// destinations are the rate limited ids of the providers
+	destinations    []*destination
 	providerOptions *ProviderOptions
 	options         *types.Options
+	writesStdout    bool
+	// dryRun receives the payloads instead of the destinations when set
+	dryRun io.Writer
 }
 
 func New(providerOptions *ProviderOptions, options *types.Options) (*Client, error) {
 
 	client := &Client{providerOptions: providerOptions, options: options}
+	utils.DefaultRedactor.Add(providerOptions.Secrets()...)
+	if options.DryRun {
+		client.dryRun = utils.DefaultRedactor.Writer(os.Stdout)
+	}
 
 	if providerOptions.Slack != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "slack")) {
 
@@ -123,6 +192,226 @@ func New(providerOptions *ProviderOptions, options *types.Options) (*Client, err
 		client.providers = append(client.providers, provider)
 	}
 
+	if providerOptions.Matrix != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "matrix")) {
+
+		provider, err := matrix.New(providerOptions.Matrix, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create matrix provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Ntfy != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "ntfy")) {
+
+		provider, err := ntfy.New(providerOptions.Ntfy, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create ntfy provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.PagerDuty != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "pagerduty")) {
+
+		provider, err := pagerduty.New(providerOptions.PagerDuty, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create pagerduty provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Opsgenie != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "opsgenie")) {
+
+		provider, err := opsgenie.New(providerOptions.Opsgenie, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create opsgenie provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Jira != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "jira")) {
+
+		provider, err := jira.New(providerOptions.Jira, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create jira provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.GithubIssues != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "github_issues")) {
+
+		provider, err := github.New(providerOptions.GithubIssues, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create github_issues provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.GitlabIssues != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "gitlab_issues")) {
+
+		provider, err := gitlab.New(providerOptions.GitlabIssues, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create gitlab_issues provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.RocketChat != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "rocketchat")) {
+
+		provider, err := rocketchat.New(providerOptions.RocketChat, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create rocketchat provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Zulip != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "zulip")) {
+
+		provider, err := zulip.New(providerOptions.Zulip, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create zulip provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Webex != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "webex")) {
+
+		provider, err := webex.New(providerOptions.Webex, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create webex provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.DingTalk != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "dingtalk")) {
+
+		provider, err := dingtalk.New(providerOptions.DingTalk, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create dingtalk provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Feishu != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "feishu")) {
+
+		provider, err := feishu.New(providerOptions.Feishu, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create feishu provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.WeCom != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "wecom")) {
+
+		provider, err := wecom.New(providerOptions.WeCom, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create wecom provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Splunk != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "splunk")) {
+
+		provider, err := splunk.New(providerOptions.Splunk, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create splunk provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Elasticsearch != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "elasticsearch")) {
+
+		provider, err := elasticsearch.New(providerOptions.Elasticsearch, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create elasticsearch provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Loki != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "loki")) {
+
+		provider, err := loki.New(providerOptions.Loki, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create loki provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.File != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "file")) {
+
+		provider, err := file.New(providerOptions.File, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create file provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Exec != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "exec")) {
+
+		provider, err := exec.New(providerOptions.Exec, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create exec provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Stdout != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "stdout")) {
+
+		provider, err := stdout.New(providerOptions.Stdout, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create stdout provider client")
+		}
+		client.providers = append(client.providers, provider)
+		client.writesStdout = len(provider.Stdout) > 0
+	}
+
+	if providerOptions.Syslog != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "syslog")) {
+
+		provider, err := syslog.New(providerOptions.Syslog, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create syslog provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Mqtt != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "mqtt")) {
+
+		provider, err := mqtt.New(providerOptions.Mqtt, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create mqtt provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Nats != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "nats")) {
+
+		provider, err := nats.New(providerOptions.Nats, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create nats provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.SMS != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "sms")) {
+
+		provider, err := sms.New(providerOptions.SMS, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create sms provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if providerOptions.Shoutrrr != nil && (len(options.Providers) == 0 || sliceutil.Contains(options.Providers, "shoutrrr")) {
+
+		provider, err := shoutrrr.New(providerOptions.Shoutrrr, options.IDs)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create shoutrrr provider client")
+		}
+		client.providers = append(client.providers, provider)
+	}
+
+	if err := client.buildDestinations(); err != nil {
+		return nil, err
+	}
 	return client, nil
 }
 
@@ -131,13 +420,43 @@ func (p *Client) Send(message string) error {
 	// strip unsupported color control chars
 	message = stripansi.Strip(message)
 
-	for _, v := range p.providers {
-		if err := v.Send(message, p.options.MessageFormat); err != nil {
-			for _, v := range multierr.Errors(err) {
-				gologger.Error().Msgf("%s", v)
-			}
+	if p.dryRun != nil {
+		for _, v := range p.providers {
+			logErrors(p.dryRunSend(v, message))
 		}
+		return nil
+	}
+	for _, v := range p.destinations {
+		logErrors(v.Send(message, p.options.MessageFormat))
 	}
 
 	return nil
 }
+
+func logErrors(err error) {
+	for _, v := range multierr.Errors(err) {
+		gologger.Error().Msgf("%s", v)
+	}
+}
+
+// WritesStdout reports whether notifications, or their payloads in dry run
+// mode, are delivered to stdout
+func (p *Client) WritesStdout() bool {
+	return p.writesStdout || p.dryRun != nil
+}
+
+// Close flushes and releases the providers buffering notifications
+func (p *Client) Close() {
+	for _, v := range p.providers {
+		if closer, ok := v.(io.Closer); ok {
+			if _, buffered := v.(DryRunner); p.dryRun != nil && !buffered {
+				// buffering http providers send their pending payloads on close
+				name, _ := optionsField(v)
+				fmt.Fprintf(p.dryRun, "[%s]\n", name)
+			}
+			if err := closer.Close(); err != nil {
+				gologger.Error().Msgf("%s", err)
+			}
+		}
+	}
+}
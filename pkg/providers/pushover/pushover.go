@@ -57,3 +57,11 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return PushoverErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("pushover_api_token", options.PushoverApiToken),
+		utils.Required("pushover_user_key", options.UserKey),
+	)
+}
@@ -0,0 +1,211 @@
+package providers
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// RateLimit allows Requests notifications every Per duration, in bursts of
+// up to Burst notifications which defaults to Requests
+type RateLimit struct {
+	Requests int           `yaml:"requests"`
+	Per      time.Duration `yaml:"per"`
+	Burst    int           `yaml:"burst,omitempty"`
+}
+
+// Validate checks the limit allows some notifications
+func (r *RateLimit) Validate() error {
+	if r.Requests <= 0 {
+		return fmt.Errorf("requests must be greater than 0")
+	}
+	if r.Per <= 0 {
+		return fmt.Errorf("per must be a duration greater than 0")
+	}
+	if r.Burst < 0 {
+		return fmt.Errorf("burst can't be negative")
+	}
+	return nil
+}
+
+func (r *RateLimit) bucket() *utils.TokenBucket {
+	if r == nil {
+		return nil
+	}
+	return utils.NewTokenBucket(r.Requests, r.Per, r.Burst)
+}
+
+// ProviderRateLimit limits the notifications sent by a provider, shared by
+// all its ids, and by each of its ids
+type ProviderRateLimit struct {
+	// Provider is shared by all the ids of the provider
+	Provider *RateLimit `yaml:"provider,omitempty"`
+	// ID applies to each id on its own
+	ID *RateLimit `yaml:"id,omitempty"`
+	// IDs replace the ID limit of some ids
+	IDs map[string]*RateLimit `yaml:"ids,omitempty"`
+}
+
+// Validate checks the limits of the provider and of its ids
+func (l *ProviderRateLimit) Validate() error {
+	for _, limit := range []*RateLimit{l.Provider, l.ID} {
+		if limit == nil {
+			continue
+		}
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+	}
+	for id, limit := range l.IDs {
+		if limit == nil {
+			continue
+		}
+		if err := limit.Validate(); err != nil {
+			return fmt.Errorf("id %s: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// defaultRateLimits follow the limits documented by the platforms, the ids
+// of these providers stand for a webhook, a channel or a chat
+var defaultRateLimits = map[string]*ProviderRateLimit{
+	"discord": {ID: &RateLimit{Requests: 5, Per: 2 * time.Second}},
+	"slack":   {ID: &RateLimit{Requests: 1, Per: time.Second}},
+	"telegram": {
+		Provider: &RateLimit{Requests: 30, Per: time.Second},
+		ID:       &RateLimit{Requests: 20, Per: time.Minute},
+	},
+}
+
+// unlimitedProviders write locally, to message brokers or to log stores
+// batching the notifications, -rate-limit doesn't apply to them
+var unlimitedProviders = map[string]bool{
+	"file": true, "stdout": true, "exec": true, "syslog": true, "mqtt": true,
+	"nats": true, "loki": true, "elasticsearch": true, "splunk": true,
+}
+
+// destination is a provider restricted to a single id along with the rate
+// limiters its notifications wait for
+type destination struct {
+	Provider
+	limiters []*utils.TokenBucket
+}
+
+func (d *destination) Send(message, CliFormat string) error {
+	for _, limiter := range d.limiters {
+		limiter.Wait()
+	}
+	return d.Provider.Send(message, CliFormat)
+}
+
+// rateLimit returns the limits of the provider, the configured ones
+// replacing the platform defaults
+func (p *ProviderOptions) rateLimit(name string) *ProviderRateLimit {
+	limit := &ProviderRateLimit{}
+	if defaults, ok := defaultRateLimits[name]; ok {
+		*limit = *defaults
+	}
+	if configured, ok := p.RateLimits[name]; ok && configured != nil {
+		if configured.Provider != nil {
+			limit.Provider = configured.Provider
+		}
+		if configured.ID != nil {
+			limit.ID = configured.ID
+		}
+		limit.IDs = configured.IDs
+	}
+	return limit
+}
+
+// idRateLimit returns the limit of a single id, falling back to
+// defaultLimit notifications per second
+func (l *ProviderRateLimit) idRateLimit(id string, defaultLimit int) *RateLimit {
+	if limit, ok := l.IDs[id]; ok && limit != nil {
+		return limit
+	}
+	if l.ID != nil {
+		return l.ID
+	}
+	if defaultLimit > 0 {
+		return &RateLimit{Requests: defaultLimit, Per: time.Second, Burst: 1}
+	}
+	return nil
+}
+
+// buildDestinations splits the providers into one destination per id so
+// that each id is rate limited on its own. The ids of the shoutrrr provider
+// get the limits of the platform of their url.
+func (p *Client) buildDestinations() error {
+	for name, limit := range p.providerOptions.RateLimits {
+		if limit == nil {
+			continue
+		}
+		if err := limit.Validate(); err != nil {
+			return fmt.Errorf("invalid rate limit of %s: %w", name, err)
+		}
+	}
+
+	shared := make(map[string]*utils.TokenBucket)
+	providerBucket := func(name string, limit *ProviderRateLimit) *utils.TokenBucket {
+		if _, ok := shared[name]; !ok {
+			shared[name] = limit.Provider.bucket()
+		}
+		return shared[name]
+	}
+
+	for _, provider := range p.providers {
+		name, index := optionsField(provider)
+		if index < 0 {
+			p.destinations = append(p.destinations, &destination{Provider: provider})
+			continue
+		}
+		options := reflect.ValueOf(provider).Elem().Field(index)
+		for i := 0; i < options.Len(); i++ {
+			option := reflect.Indirect(options.Index(i))
+			platform := name
+			if name == "shoutrrr" {
+				platform = shoutrrrPlatform(option.FieldByName("URL").String())
+			}
+			limit, bucketName := p.providerOptions.rateLimit(platform), platform
+			if name != platform {
+				// the shoutrrr settings apply over those of the platform
+				configured := p.providerOptions.rateLimit(name)
+				if configured.Provider != nil {
+					limit.Provider, bucketName = configured.Provider, name
+				}
+				if configured.ID != nil {
+					limit.ID = configured.ID
+				}
+				limit.IDs = configured.IDs
+			}
+
+			d := &destination{Provider: singleOptionProvider(provider, index, options.Index(i))}
+			if bucket := providerBucket(bucketName, limit); bucket != nil {
+				d.limiters = append(d.limiters, bucket)
+			}
+			defaultLimit := p.options.RateLimit
+			if unlimitedProviders[name] {
+				defaultLimit = 0
+			}
+			if bucket := limit.idRateLimit(option.FieldByName("ID").String(), defaultLimit).bucket(); bucket != nil {
+				d.limiters = append(d.limiters, bucket)
+			}
+			p.destinations = append(p.destinations, d)
+		}
+	}
+	return nil
+}
+
+// shoutrrrPlatform returns the provider matching the service of a shoutrrr url
+func shoutrrrPlatform(serviceURL string) string {
+	u, err := url.Parse(serviceURL)
+	if err != nil {
+		return "shoutrrr"
+	}
+	return strings.ToLower(u.Scheme)
+}
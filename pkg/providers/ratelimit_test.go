@@ -0,0 +1,119 @@
+package providers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/providers/custom"
+	"github.com/projectdiscovery/notify/pkg/providers/discord"
+	"github.com/projectdiscovery/notify/pkg/providers/googlechat"
+	"github.com/projectdiscovery/notify/pkg/providers/shoutrrr"
+	"github.com/projectdiscovery/notify/pkg/providers/telegram"
+	"github.com/projectdiscovery/notify/pkg/types"
+)
+
+func TestRateLimitPerID(t *testing.T) {
+	var mutex sync.Mutex
+	received := make(map[string][]time.Time)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		received[r.URL.Path] = append(received[r.URL.Path], time.Now())
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	client, err := New(&ProviderOptions{
+		Custom: []*custom.Options{
+			{ID: "a", CustomWebhookURL: server.URL + "/a", CustomMethod: http.MethodPost},
+			{ID: "b", CustomWebhookURL: server.URL + "/b", CustomMethod: http.MethodPost},
+		},
+		RateLimits: map[string]*ProviderRateLimit{
+			"custom": {ID: &RateLimit{Requests: 1, Per: 100 * time.Millisecond}},
+		},
+	}, &types.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_ = client.Send("message")
+	}
+	elapsed := time.Since(start)
+
+	for _, path := range []string{"/a", "/b"} {
+		times := received[path]
+		if len(times) != 3 {
+			t.Fatalf("expected 3 notifications to %s, got %d", path, len(times))
+		}
+		if gap := times[2].Sub(times[0]); gap < 190*time.Millisecond {
+			t.Errorf("expected the notifications to %s to be spread over 200ms, got %s", path, gap)
+		}
+	}
+	// each id has its own bucket, a shared one would take 500ms
+	if elapsed > 400*time.Millisecond {
+		t.Errorf("expected the ids to be limited separately, took %s", elapsed)
+	}
+}
+
+func TestRateLimitDefaults(t *testing.T) {
+	client, err := New(&ProviderOptions{
+		Discord: []*discord.Options{{ID: "webhook", DiscordWebHookURL: "https://discord.com/api/webhooks/123456789012345678/token"}},
+		Telegram: []*telegram.Options{
+			{ID: "group-1", TelegramAPIKey: "123:token", TelegramChatID: "-1"},
+			{ID: "group-2", TelegramAPIKey: "123:token", TelegramChatID: "-2"},
+		},
+		Shoutrrr: []*shoutrrr.Options{{ID: "url-1", URL: "discord://token@123456789012345678"}},
+		RateLimits: map[string]*ProviderRateLimit{
+			"telegram": {IDs: map[string]*RateLimit{"group-2": {Requests: 1, Per: time.Second}}},
+		},
+	}, &types.Options{RateLimit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(client.destinations) != 4 {
+		t.Fatalf("expected a destination per id, got %d", len(client.destinations))
+	}
+	discordID, group1, group2, shoutrrrID := client.destinations[0], client.destinations[1], client.destinations[2], client.destinations[3]
+	if len(discordID.limiters) != 1 || len(shoutrrrID.limiters) != 1 {
+		t.Errorf("expected discord ids to be limited per webhook only")
+	}
+	if len(group1.limiters) != 2 || len(group2.limiters) != 2 {
+		t.Fatalf("expected telegram ids to be limited globally and per chat")
+	}
+	if group1.limiters[0] != group2.limiters[0] {
+		t.Errorf("expected the telegram ids to share the global limit")
+	}
+	if group1.limiters[1] == group2.limiters[1] {
+		t.Errorf("expected each telegram id to have its own limit")
+	}
+}
+
+func TestRateLimitProviderName(t *testing.T) {
+	// the googlechat provider struct is tagged googleChat
+	client, err := New(&ProviderOptions{
+		GoogleChat: []*googlechat.Options{{ID: "space", Space: "space", Key: "key", Token: "token"}},
+		RateLimits: map[string]*ProviderRateLimit{
+			"googlechat": {Provider: &RateLimit{Requests: 1, Per: time.Minute}},
+		},
+	}, &types.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(client.destinations) != 1 || len(client.destinations[0].limiters) != 1 {
+		t.Fatalf("expected the googlechat rate limit to be applied")
+	}
+}
+
+func TestRateLimitInvalid(t *testing.T) {
+	_, err := New(&ProviderOptions{RateLimits: map[string]*ProviderRateLimit{
+		"slack": {ID: &RateLimit{Requests: 1}},
+	}}, &types.Options{})
+	if err == nil {
+		t.Fatal("expected a rate limit without a duration to be rejected")
+	}
+}
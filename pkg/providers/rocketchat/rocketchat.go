@@ -0,0 +1,93 @@
+package rocketchat
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	RocketChat []*Options `yaml:"rocketchat,omitempty"`
+	counter    int
+}
+
+type Options struct {
+	ID                   string `yaml:"id,omitempty"`
+	RocketChatWebhookURL string `yaml:"rocketchat_webhook_url,omitempty"`
+	RocketChatServerURL  string `yaml:"rocketchat_server_url,omitempty"`
+	RocketChatUserID     string `yaml:"rocketchat_user_id,omitempty"`
+	RocketChatToken      string `yaml:"rocketchat_token,omitempty"`
+	RocketChatChannel    string `yaml:"rocketchat_channel,omitempty"`
+	RocketChatAlias      string `yaml:"rocketchat_alias,omitempty"`
+	RocketChatEmoji      string `yaml:"rocketchat_emoji,omitempty"`
+	RocketChatAvatar     string `yaml:"rocketchat_avatar,omitempty"`
+	RocketChatFormat     string `yaml:"rocketchat_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.RocketChat = append(provider.RocketChat, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var RocketChatErr error
+	p.counter++
+	for _, pr := range p.RocketChat {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.RocketChatFormat), p.counter)
+
+		var err error
+		switch {
+		case pr.RocketChatWebhookURL != "":
+			err = pr.SendWebhook(msg)
+		case pr.RocketChatServerURL != "":
+			if pr.RocketChatUserID == "" || pr.RocketChatToken == "" || pr.RocketChatChannel == "" {
+				err = fmt.Errorf("rocketchat_user_id, rocketchat_token and rocketchat_channel values are required to use the REST API")
+				break
+			}
+			err = pr.SendPostMessage(msg)
+		default:
+			err = fmt.Errorf("rocketchat_webhook_url or rocketchat_server_url value is required")
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send rocketchat notification for id: %s ", pr.ID))
+			RocketChatErr = multierr.Append(RocketChatErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("rocketchat notification sent for id: %s", pr.ID)
+	}
+	return RocketChatErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.ValidURL("rocketchat_webhook_url", options.RocketChatWebhookURL),
+		utils.ValidURL("rocketchat_server_url", options.RocketChatServerURL),
+	)
+	switch {
+	case options.RocketChatWebhookURL != "":
+	case options.RocketChatServerURL != "":
+		err = multierr.Combine(err,
+			utils.Required("rocketchat_user_id", options.RocketChatUserID),
+			utils.Required("rocketchat_token", options.RocketChatToken),
+			utils.Required("rocketchat_channel", options.RocketChatChannel),
+		)
+	default:
+		err = multierr.Append(err, fmt.Errorf("rocketchat_webhook_url or rocketchat_server_url value is required"))
+	}
+	return err
+}
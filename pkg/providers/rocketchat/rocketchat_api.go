@@ -0,0 +1,58 @@
+package rocketchat
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const postMessageAPIPath = "/api/v1/chat.postMessage"
+
+func (options *Options) payload(message string) *APIRequest {
+	return &APIRequest{
+		Channel: options.RocketChatChannel,
+		Text:    message,
+		Alias:   options.RocketChatAlias,
+		Emoji:   options.RocketChatEmoji,
+		Avatar:  options.RocketChatAvatar,
+	}
+}
+
+// SendWebhook posts the message to an incoming webhook integration
+func (options *Options) SendWebhook(message string) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+
+	var response *APIResponse
+	err := httpreq.NewClient().Post(options.RocketChatWebhookURL, options.payload(message), headers, &response)
+	if err != nil {
+		return err
+	}
+	if !response.Success {
+		return fmt.Errorf("error while sending rocketchat message: %s ", response.Error)
+	}
+	return nil
+}
+
+// SendPostMessage posts the message using the chat.postMessage REST API
+func (options *Options) SendPostMessage(message string) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+		"X-User-Id":    {options.RocketChatUserID},
+		"X-Auth-Token": {options.RocketChatToken},
+	}
+
+	var response *APIResponse
+	apiURL := strings.TrimSuffix(options.RocketChatServerURL, "/") + postMessageAPIPath
+	err := httpreq.NewClient().Post(apiURL, options.payload(message), headers, &response)
+	if err != nil {
+		return err
+	}
+	if !response.Success {
+		return fmt.Errorf("error while sending rocketchat message: %s ", response.Error)
+	}
+	return nil
+}
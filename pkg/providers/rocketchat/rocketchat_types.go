@@ -0,0 +1,14 @@
+package rocketchat
+
+type APIRequest struct {
+	Channel string `json:"channel,omitempty"`
+	Text    string `json:"text"`
+	Alias   string `json:"alias,omitempty"`
+	Emoji   string `json:"emoji,omitempty"`
+	Avatar  string `json:"avatar,omitempty"`
+}
+
+type APIResponse struct {
+	Success bool   `json:"success,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
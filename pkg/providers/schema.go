@@ -0,0 +1,62 @@
+package providers
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+const schemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// JSONSchema returns the JSON Schema of the provider config generated from
+// the provider options, for editor validation and autocompletion
+func JSONSchema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeOf(ProviderOptions{}))
+	schema["$schema"] = schemaDraft
+	schema["title"] = "notify provider config"
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == durationType {
+		return map[string]interface{}{
+			"type":        "string",
+			"description": "duration such as 500ms, 10s or 1h30m",
+			"pattern":     `^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`,
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": typeSchema(t.Elem())}
+	case reflect.Struct:
+		properties := make(map[string]interface{})
+		for name, field := range yamlFields(t) {
+			properties[name] = typeSchema(field.Type)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	default:
+		return map[string]interface{}{}
+	}
+}
@@ -0,0 +1,111 @@
+package shoutrrr
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/containrrr/shoutrrr"
+	"github.com/containrrr/shoutrrr/pkg/router"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+// offlineServices are the shoutrrr services not sending through the default
+// http client, which aren't sent at all in dry run mode
+var offlineServices = []string{"smtp", "gotify", "logger"}
+
+type Provider struct {
+	Shoutrrr []*Options `yaml:"shoutrrr,omitempty"`
+	counter  int
+}
+
+type Options struct {
+	ID             string `yaml:"id,omitempty"`
+	URL            string `yaml:"url,omitempty"`
+	ShoutrrrFormat string `yaml:"format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if err := o.locate(); err != nil {
+				return nil, errors.Wrap(err, fmt.Sprintf("invalid shoutrrr url for id: %s ", o.ID))
+			}
+			provider.Shoutrrr = append(provider.Shoutrrr, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var ShoutrrrErr error
+	p.counter++
+	for _, pr := range p.Shoutrrr {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ShoutrrrFormat), p.counter)
+		err := shoutrrr.Send(pr.URL, msg)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send shoutrrr notification for id: %s ", pr.ID))
+			ShoutrrrErr = multierr.Append(ShoutrrrErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("shoutrrr notification sent for id: %s", pr.ID)
+	}
+	return ShoutrrrErr
+}
+
+// DryRun sends the notifications of http services through the dry run
+// transport and writes the message of the other services
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var ShoutrrrErr error
+	p.counter++
+	for _, pr := range p.Shoutrrr {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ShoutrrrFormat), p.counter)
+
+		scheme, err := pr.scheme()
+		switch {
+		case err != nil:
+		case sliceutil.Contains(offlineServices, scheme):
+			err = utils.WriteDryRun(w, pr.ID, fmt.Sprintf("SEND %s\n%s", scheme, msg))
+		default:
+			err = shoutrrr.Send(pr.URL, msg)
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run shoutrrr notification for id: %s ", pr.ID))
+			ShoutrrrErr = multierr.Append(ShoutrrrErr, err)
+		}
+	}
+	return ShoutrrrErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	if err := utils.Required("url", options.URL); err != nil {
+		return err
+	}
+	if err := options.locate(); err != nil {
+		return utils.NewFieldError("url", "%v", err)
+	}
+	return nil
+}
+
+// locate parses the url with the shoutrrr router, which checks that the
+// service exists and that its url is complete
+func (options *Options) locate() error {
+	_, err := (&router.ServiceRouter{}).Locate(options.URL)
+	return err
+}
+
+// scheme returns the shoutrrr service of the url
+func (options *Options) scheme() (string, error) {
+	scheme, _, err := (&router.ServiceRouter{}).ExtractServiceName(options.URL)
+	return scheme, err
+}
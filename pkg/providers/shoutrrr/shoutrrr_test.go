@@ -0,0 +1,56 @@
+package shoutrrr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSendGeneric(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	provider, err := New([]*Options{{
+		ID:             "generic",
+		URL:            "generic+" + server.URL + "/hook",
+		ShoutrrrFormat: "[{{count}}] {{data}}",
+	}}, nil)
+	if err != nil {
+		t.Fatalf("could not create provider: %s", err)
+	}
+	if err := provider.Send("hello", ""); err != nil {
+		t.Fatalf("could not send: %s", err)
+	}
+	if string(body) != "[1] hello" {
+		t.Errorf("unexpected body %q", body)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{"", "url: value is required"},
+		{"nope://token@host", `unknown service "nope"`},
+		{"telegram://token@telegram", "invalid telegram token"},
+		{"discord://token@channel", ""},
+	}
+	for _, test := range tests {
+		err := (&Options{URL: test.url}).Validate()
+		switch {
+		case test.expected == "" && err != nil:
+			t.Errorf("expected %q to be valid, got %s", test.url, err)
+		case test.expected != "" && (err == nil || !strings.Contains(err.Error(), test.expected)):
+			t.Errorf("expected error containing %q for %q, got %v", test.expected, test.url, err)
+		}
+	}
+	if _, err := New([]*Options{{ID: "bad", URL: "nope://host"}}, nil); err == nil {
+		t.Error("expected an invalid url to be rejected at config load")
+	}
+}
@@ -89,3 +89,37 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return SlackErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	if options.SlackThreads {
+		return multierr.Combine(
+			utils.Required("slack_token", options.SlackToken),
+			utils.Required("slack_channel", options.SlackChannel),
+		)
+	}
+	if err := utils.Required("slack_webhook_url", options.SlackWebHookURL); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(options.SlackWebHookURL, "https://hooks.slack.com/services/") {
+		return utils.NewFieldError("slack_webhook_url", "expected a https://hooks.slack.com/services/ url")
+	}
+	return nil
+}
+
+// HealthCheck verifies bot tokens with auth.test, incoming webhooks have no
+// read-only endpoint and receive a test message instead
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Slack {
+		if pr.SlackToken != "" {
+			results = append(results, utils.CheckHealth(pr.ID, false, pr.AuthTest))
+			continue
+		}
+		single := &Provider{Slack: []*Options{pr}}
+		results = append(results, utils.CheckHealth(pr.ID, true, func() error {
+			return single.Send(utils.TestMessage, "")
+		}))
+	}
+	return results
+}
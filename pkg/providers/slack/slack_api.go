@@ -7,7 +7,10 @@ import (
 	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
 )
 
-const SlackPostMessageAPI = "https://slack.com/api/chat.postMessage"
+const (
+	SlackPostMessageAPI = "https://slack.com/api/chat.postMessage"
+	SlackAuthTestAPI    = "https://slack.com/api/auth.test"
+)
 
 func (options *Options) SendThreaded(message string) error {
 
@@ -37,3 +40,20 @@ func (options *Options) SendThreaded(message string) error {
 	}
 	return nil
 }
+
+// AuthTest checks the slack token without posting a message
+func (options *Options) AuthTest() error {
+	headers := http.Header{
+		"Content-Type":  {"application/json"},
+		"Authorization": {fmt.Sprintf("Bearer %s", options.SlackToken)},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(SlackAuthTestAPI, struct{}{}, headers, &response); err != nil {
+		return err
+	}
+	if !response.Ok {
+		return fmt.Errorf("slack token check failed: %s", response.Error)
+	}
+	return nil
+}
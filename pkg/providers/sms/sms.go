@@ -0,0 +1,132 @@
+package sms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultBaseURL       = "https://api.twilio.com"
+	defaultVoiceLanguage = "en-US"
+	// maxSayLength keeps the spoken message short enough to be useful
+	maxSayLength = 500
+)
+
+type Provider struct {
+	SMS     []*Options `yaml:"sms,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                     string   `yaml:"id,omitempty"`
+	SMSBaseURL             string   `yaml:"sms_base_url,omitempty"`
+	SMSAccountSID          string   `yaml:"sms_account_sid,omitempty"`
+	SMSAuthToken           string   `yaml:"sms_auth_token,omitempty"`
+	SMSFrom                string   `yaml:"sms_from,omitempty"`
+	SMSMessagingServiceSID string   `yaml:"sms_messaging_service_sid,omitempty"`
+	SMSTo                  []string `yaml:"sms_to,omitempty"`
+	SMSMaxSegments         int      `yaml:"sms_max_segments,omitempty"`
+	SMSVoiceCall           string   `yaml:"sms_voice_call,omitempty"`
+	SMSVoiceLanguage       string   `yaml:"sms_voice_language,omitempty"`
+	SMSFormat              string   `yaml:"sms_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.SMSBaseURL == "" {
+				o.SMSBaseURL = defaultBaseURL
+			}
+			if o.SMSMaxSegments == 0 {
+				o.SMSMaxSegments = 1
+			}
+			if o.SMSVoiceLanguage == "" {
+				o.SMSVoiceLanguage = defaultVoiceLanguage
+			}
+			provider.SMS = append(provider.SMS, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var SMSErr error
+	p.counter++
+	for _, pr := range p.SMS {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.SMSFormat), p.counter)
+
+		if pr.SMSAccountSID == "" || pr.SMSAuthToken == "" || len(pr.SMSTo) == 0 || (pr.SMSFrom == "" && pr.SMSMessagingServiceSID == "") {
+			err := errors.Wrap(fmt.Errorf("sms_account_sid, sms_auth_token, sms_to and sms_from or sms_messaging_service_sid values are required"),
+				fmt.Sprintf("failed to send sms notification for id: %s ", pr.ID))
+			SMSErr = multierr.Append(SMSErr, err)
+			continue
+		}
+
+		voiceCall, err := utils.RenderTemplate(pr.SMSVoiceCall, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render sms voice call condition for id: %s ", pr.ID))
+			SMSErr = multierr.Append(SMSErr, err)
+			continue
+		}
+		call := strings.EqualFold(strings.TrimSpace(voiceCall), "true")
+		if call && pr.SMSFrom == "" {
+			err := errors.Wrap(fmt.Errorf("sms_from value is required for voice calls"),
+				fmt.Sprintf("failed to send sms notification for id: %s ", pr.ID))
+			SMSErr = multierr.Append(SMSErr, err)
+			continue
+		}
+
+		body := truncateToSegments(msg, pr.SMSMaxSegments)
+		var sendErr error
+		for _, to := range pr.SMSTo {
+			if err := pr.SendMessage(to, body); err != nil {
+				sendErr = multierr.Append(sendErr, errors.Wrapf(err, "could not send sms to %s", to))
+			}
+			if call {
+				say := []rune(msg)
+				if len(say) > maxSayLength {
+					say = say[:maxSayLength]
+				}
+				if err := pr.Call(to, string(say)); err != nil {
+					sendErr = multierr.Append(sendErr, errors.Wrapf(err, "could not call %s", to))
+				}
+			}
+		}
+		if sendErr != nil {
+			err = errors.Wrap(sendErr, fmt.Sprintf("failed to send sms notification for id: %s ", pr.ID))
+			SMSErr = multierr.Append(SMSErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("sms notification sent for id: %s", pr.ID)
+	}
+	return SMSErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.ValidURL("sms_base_url", options.SMSBaseURL),
+		utils.Required("sms_account_sid", options.SMSAccountSID),
+		utils.Required("sms_auth_token", options.SMSAuthToken),
+		utils.RequiredList("sms_to", options.SMSTo),
+	)
+	if options.SMSFrom == "" && options.SMSMessagingServiceSID == "" {
+		err = multierr.Append(err, fmt.Errorf("sms_from or sms_messaging_service_sid value is required"))
+	}
+	if options.SMSMaxSegments < 0 {
+		err = multierr.Append(err, utils.NewFieldError("sms_max_segments", "expected a positive value"))
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package sms
+
+import (
+	"encoding/base64"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// SendMessage sends an SMS through the Messages API
+func (options *Options) SendMessage(to, body string) error {
+	values := url.Values{
+		"To":   {to},
+		"Body": {body},
+	}
+	if options.SMSMessagingServiceSID != "" {
+		values.Set("MessagingServiceSid", options.SMSMessagingServiceSID)
+	} else {
+		values.Set("From", options.SMSFrom)
+	}
+	return options.post("Messages.json", values)
+}
+
+// Call places a voice call reading the message with TwiML <Say>
+func (options *Options) Call(to, message string) error {
+	twiml := fmt.Sprintf(`<Response><Say language="%s">%s</Say></Response>`,
+		html.EscapeString(options.SMSVoiceLanguage), html.EscapeString(message))
+	values := url.Values{
+		"To":    {to},
+		"From":  {options.SMSFrom},
+		"Twiml": {twiml},
+	}
+	return options.post("Calls.json", values)
+}
+
+func (options *Options) post(resource string, values url.Values) error {
+	endpoint := fmt.Sprintf("%s/2010-04-01/Accounts/%s/%s",
+		strings.TrimSuffix(options.SMSBaseURL, "/"), url.PathEscape(options.SMSAccountSID), resource)
+
+	credentials := base64.StdEncoding.EncodeToString([]byte(options.SMSAccountSID + ":" + options.SMSAuthToken))
+	headers := http.Header{
+		"Authorization": {fmt.Sprintf("Basic %s", credentials)},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().PostForm(endpoint, values, headers, &response); err != nil {
+		return err
+	}
+	if response == nil || response.SID == "" {
+		if response != nil && response.Message != "" {
+			return fmt.Errorf("twilio error %d: %s", response.Code, response.Message)
+		}
+		return fmt.Errorf("unexpected twilio response")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package sms
+
+import "strings"
+
+// GSM 03.38 character sets, extension characters take two septets
+const (
+	gsmBasic     = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà"
+	gsmExtension = "\f^{}\\[~]|€"
+)
+
+const (
+	gsmSingleSegment  = 160
+	gsmMultiSegment   = 153
+	ucs2SingleSegment = 70
+	ucs2MultiSegment  = 67
+	truncationMarker  = "..."
+)
+
+// truncateToSegments shortens the message so it fits in maxSegments SMS
+// segments, using the GSM-7 limits when possible and UCS-2 otherwise
+func truncateToSegments(message string, maxSegments int) string {
+	if maxSegments <= 0 {
+		return message
+	}
+	gsm := isGSM(message)
+	single, multi := ucs2SingleSegment, ucs2MultiSegment
+	if gsm {
+		single, multi = gsmSingleSegment, gsmMultiSegment
+	}
+	limit := single
+	if maxSegments > 1 {
+		limit = multi * maxSegments
+	}
+	if messageLength(message, gsm) <= limit {
+		return message
+	}
+
+	limit -= len(truncationMarker)
+	var builder strings.Builder
+	length := 0
+	for _, r := range message {
+		size := runeLength(r, gsm)
+		if length+size > limit {
+			break
+		}
+		length += size
+		builder.WriteRune(r)
+	}
+	return builder.String() + truncationMarker
+}
+
+func isGSM(message string) bool {
+	for _, r := range message {
+		if !strings.ContainsRune(gsmBasic, r) && !strings.ContainsRune(gsmExtension, r) {
+			return false
+		}
+	}
+	return true
+}
+
+func messageLength(message string, gsm bool) int {
+	length := 0
+	for _, r := range message {
+		length += runeLength(r, gsm)
+	}
+	return length
+}
+
+func runeLength(r rune, gsm bool) int {
+	if gsm {
+		if strings.ContainsRune(gsmExtension, r) {
+			return 2
+		}
+		return 1
+	}
+	// characters outside the BMP take a surrogate pair
+	if r > 0xFFFF {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,82 @@
+package sms
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestSend(t *testing.T) {
+	requests := map[string][]url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "AC123" || pass != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = json.NewEncoder(w).Encode(APIResponse{Code: 20003, Message: "Authenticate"})
+			return
+		}
+		_ = r.ParseForm()
+		requests[r.URL.Path] = append(requests[r.URL.Path], r.PostForm)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(APIResponse{SID: "SM123", Status: "queued"})
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:            "sms",
+		SMSBaseURL:    server.URL,
+		SMSAccountSID: "AC123",
+		SMSAuthToken:  "token",
+		SMSFrom:       "+15005550006",
+		SMSTo:         []string{"+15005550001", "+15005550002"},
+		SMSVoiceCall:  `{{ eq .severity "critical" }}`,
+	}}, nil)
+
+	if err := provider.Send(`{"severity":"low"}`, "{{data}}"); err != nil {
+		t.Fatalf("could not send sms: %s", err)
+	}
+	if err := provider.Send(`{"severity":"critical"}`, "critical finding "+strings.Repeat("x", 200)); err != nil {
+		t.Fatalf("could not send sms: %s", err)
+	}
+
+	messages := requests["/2010-04-01/Accounts/AC123/Messages.json"]
+	calls := requests["/2010-04-01/Accounts/AC123/Calls.json"]
+	if len(messages) != 4 || len(calls) != 2 {
+		t.Fatalf("expected 4 messages and 2 calls, got %d and %d", len(messages), len(calls))
+	}
+	if body := messages[2].Get("Body"); len(body) != gsmSingleSegment || !strings.HasSuffix(body, truncationMarker) {
+		t.Errorf("expected a truncated single segment body, got %d characters", len(body))
+	}
+	if twiml := calls[0].Get("Twiml"); !strings.HasPrefix(twiml, `<Response><Say language="en-US">critical finding`) {
+		t.Errorf("unexpected twiml %q", twiml)
+	}
+
+	provider.SMS[0].SMSAuthToken = "invalid"
+	if err := provider.Send("hello", ""); err == nil || !strings.Contains(err.Error(), "twilio error 20003") {
+		t.Errorf("expected an authentication error, got %v", err)
+	}
+}
+
+func TestTruncateToSegments(t *testing.T) {
+	tests := []struct {
+		message     string
+		maxSegments int
+		expected    int
+	}{
+		{strings.Repeat("a", 160), 1, 160},
+		{strings.Repeat("a", 161), 1, 160},
+		{strings.Repeat("a", 400), 2, 306},
+		{strings.Repeat("€", 100), 1, 159},
+		{strings.Repeat("ü", 100), 1, 100},
+		{strings.Repeat("漢", 100), 1, 70},
+		{strings.Repeat("漢", 100), 0, 100},
+	}
+	for _, test := range tests {
+		truncated := truncateToSegments(test.message, test.maxSegments)
+		if length := messageLength(truncated, isGSM(truncated)); length != test.expected {
+			t.Errorf("expected length %d, got %d for %q", test.expected, length, truncated)
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package sms
+
+// APIResponse is the subset of the Twilio message and call resources used by notify
+type APIResponse struct {
+	SID     string `json:"sid,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
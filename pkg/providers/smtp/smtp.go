@@ -67,3 +67,21 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return SmtpErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("smtp_server", options.Server),
+		utils.Required("from_address", options.FromAddress),
+		utils.RequiredList("smtp_cc", options.SMTPCC),
+	)
+}
+
+// HealthCheck connects to the server and authenticates without sending a mail
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.SMTP {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
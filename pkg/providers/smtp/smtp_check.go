@@ -0,0 +1,58 @@
+package smtp
+
+import (
+	"crypto/tls"
+	"net"
+	netsmtp "net/smtp"
+	"strings"
+	"time"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	// defaultPort is used by shoutrrr when smtp_server has no port
+	defaultPort = "25"
+)
+
+// CheckConnection greets the server with EHLO, upgrades the connection with
+// STARTTLS when available and authenticates with the configured credentials
+func (options *Options) CheckConnection() error {
+	host, address := options.serverAddress()
+	conn, err := net.DialTimeout("tcp", address, dialTimeout)
+	if err != nil {
+		return err
+	}
+	_ = conn.SetDeadline(time.Now().Add(dialTimeout))
+
+	client, err := netsmtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Hello("localhost"); err != nil {
+		return err
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok && !options.DisableStartTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if options.Username != "" {
+		if err := client.Auth(netsmtp.PlainAuth("", options.Username, options.Password, host)); err != nil {
+			return err
+		}
+	}
+	return client.Quit()
+}
+
+// serverAddress returns the host of smtp_server and its address to dial,
+// on the default port when none is given
+func (options *Options) serverAddress() (string, string) {
+	if host, _, err := net.SplitHostPort(options.Server); err == nil {
+		return host, options.Server
+	}
+	host := strings.Trim(options.Server, "[]")
+	return host, net.JoinHostPort(host, defaultPort)
+}
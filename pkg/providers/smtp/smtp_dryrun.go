@@ -0,0 +1,63 @@
+package smtp
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+)
+
+// dryRunBoundary stands in for the random multipart boundary of shoutrrr
+const dryRunBoundary = "notify-dry-run-boundary"
+
+// DryRun writes the SMTP transaction and message each recipient would receive
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var SmtpErr error
+	p.counter++
+	for _, pr := range p.SMTP {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.SMTPFormat), p.counter)
+		for _, to := range pr.SMTPCC {
+			if err := utils.WriteDryRun(w, pr.ID, pr.transaction(to, msg, time.Now())); err != nil {
+				err = errors.Wrap(err, fmt.Sprintf("failed to dry run smtp notification for id: %s ", pr.ID))
+				SmtpErr = multierr.Append(SmtpErr, err)
+			}
+		}
+	}
+	return SmtpErr
+}
+
+// transaction mirrors the commands and message written by shoutrrr, with
+// the headers sorted and a fixed multipart boundary
+func (options *Options) transaction(to, msg string, date time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "SMTP %s starttls=%t\n", options.Server, !options.DisableStartTLS)
+	if options.Username != "" {
+		fmt.Fprintf(&b, "AUTH PLAIN %s\n", options.Username)
+	}
+	fmt.Fprintf(&b, "MAIL FROM:<%s>\nRCPT TO:<%s>\nDATA\n", options.FromAddress, to)
+
+	contentType := `text/plain; charset="UTF-8"`
+	if options.HTML {
+		contentType = "multipart/alternative; boundary=" + dryRunBoundary
+	}
+	fmt.Fprintf(&b, "Content-Type: %s\n", contentType)
+	fmt.Fprintf(&b, "Date: %s\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "From:  <%s>\n", options.FromAddress)
+	b.WriteString("MIME-version: 1.0\n")
+	fmt.Fprintf(&b, "Subject: %s\n", options.Subject)
+	fmt.Fprintf(&b, "To: %s\n\n", to)
+
+	if !options.HTML {
+		b.WriteString(msg)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "\n\n--%s\nContent-Type: text/plain; charset=\"UTF-8\"\n\n%s", dryRunBoundary, msg)
+	fmt.Fprintf(&b, "\n\n--%s\nContent-Type: text/html; charset=\"UTF-8\"\n\n%s", dryRunBoundary, msg)
+	fmt.Fprintf(&b, "\n\n--%s--", dryRunBoundary)
+	return b.String()
+}
@@ -31,3 +31,17 @@ func TestBuildUrl(t *testing.T) {
 		}
 	})
 }
+
+func TestServerAddress(t *testing.T) {
+	for server, expected := range map[string][2]string{
+		"mail.example.com":     {"mail.example.com", "mail.example.com:25"},
+		"mail.example.com:587": {"mail.example.com", "mail.example.com:587"},
+		"[::1]:2525":           {"::1", "[::1]:2525"},
+		"::1":                  {"::1", "[::1]:25"},
+	} {
+		host, address := (&Options{Server: server}).serverAddress()
+		if host != expected[0] || address != expected[1] {
+			t.Errorf("%s: expected %v, got %s and %s", server, expected, host, address)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package splunk
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/utils/batcher"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultBatchSize     = 100
+	defaultFlushInterval = 5 * time.Second
+)
+
+type Provider struct {
+	Splunk  []*Options `yaml:"splunk,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                  string        `yaml:"id,omitempty"`
+	SplunkURL           string        `yaml:"splunk_url,omitempty"`
+	SplunkToken         string        `yaml:"splunk_token,omitempty"`
+	SplunkIndex         string        `yaml:"splunk_index,omitempty"`
+	SplunkSourcetype    string        `yaml:"splunk_sourcetype,omitempty"`
+	SplunkSource        string        `yaml:"splunk_source,omitempty"`
+	SplunkHost          string        `yaml:"splunk_host,omitempty"`
+	SplunkBatchSize     int           `yaml:"splunk_batch_size,omitempty"`
+	SplunkFlushInterval time.Duration `yaml:"splunk_flush_interval,omitempty"`
+	SplunkFormat        string        `yaml:"splunk_format,omitempty"`
+
+	batcher *batcher.Batcher[[]byte]
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			if o.SplunkBatchSize <= 0 {
+				o.SplunkBatchSize = defaultBatchSize
+			}
+			if o.SplunkFlushInterval <= 0 {
+				o.SplunkFlushInterval = defaultFlushInterval
+			}
+			o.batcher = batcher.New[[]byte](
+				batcher.WithMaxCapacity[[]byte](o.SplunkBatchSize),
+				batcher.WithFlushInterval[[]byte](o.SplunkFlushInterval),
+				batcher.WithFlushCallback[[]byte](o.flush),
+			)
+			o.batcher.Run()
+			provider.Splunk = append(provider.Splunk, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var SplunkErr error
+	p.counter++
+	for _, pr := range p.Splunk {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.SplunkFormat), p.counter)
+
+		if pr.SplunkURL == "" || pr.SplunkToken == "" {
+			err := errors.Wrap(fmt.Errorf("splunk_url and splunk_token values are required"),
+				fmt.Sprintf("failed to send splunk notification for id: %s ", pr.ID))
+			SplunkErr = multierr.Append(SplunkErr, err)
+			continue
+		}
+
+		event, err := pr.buildEvent(msg, time.Now())
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build splunk event for id: %s ", pr.ID))
+			SplunkErr = multierr.Append(SplunkErr, err)
+			continue
+		}
+		pr.batcher.Append(event)
+	}
+	return SplunkErr
+}
+
+// flush delivers a batch of buffered events
+func (options *Options) flush(events [][]byte) {
+	if err := options.SendEvents(events); err != nil {
+		gologger.Error().Msgf("failed to send splunk notification for id: %s: %s", options.ID, err)
+		return
+	}
+	gologger.Verbose().Msgf("splunk notification sent for id: %s (%d events)", options.ID, len(events))
+}
+
+// Close flushes the buffered events
+func (p *Provider) Close() error {
+	for _, pr := range p.Splunk {
+		pr.batcher.Stop()
+		pr.batcher.WaitDone()
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("splunk_url", options.SplunkURL),
+		utils.ValidURL("splunk_url", options.SplunkURL),
+		utils.Required("splunk_token", options.SplunkToken),
+	)
+}
+
+// HealthCheck queries the HTTP Event Collector health endpoint
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Splunk {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
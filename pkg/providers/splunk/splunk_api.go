@@ -0,0 +1,71 @@
+package splunk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const (
+	eventCollectorPath = "/services/collector/event"
+	healthPath         = "/services/collector/health"
+)
+
+// buildEvent wraps the message into a HEC event, keeping JSON messages structured
+func (options *Options) buildEvent(message string, ts time.Time) ([]byte, error) {
+	event := Event{
+		Time:       float64(ts.UnixNano()) / float64(time.Second),
+		Host:       options.SplunkHost,
+		Source:     options.SplunkSource,
+		Sourcetype: options.SplunkSourcetype,
+		Index:      options.SplunkIndex,
+	}
+	if trimmed := strings.TrimSpace(message); strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed)) {
+		event.Event = json.RawMessage(trimmed)
+	} else {
+		encoded, err := json.Marshal(message)
+		if err != nil {
+			return nil, err
+		}
+		event.Event = encoded
+	}
+	return json.Marshal(event)
+}
+
+// SendEvents posts a batch of events to the HTTP event collector
+func (options *Options) SendEvents(events [][]byte) error {
+	body := bytes.Join(events, []byte("\n"))
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(options.SplunkURL, "/")+eventCollectorPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Splunk %s", options.SplunkToken))
+
+	res, err := httpreq.NewClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response APIResponse
+	if err := json.NewDecoder(io.LimitReader(res.Body, 4096)).Decode(&response); err != nil || res.StatusCode != http.StatusOK || response.Code != 0 {
+		return fmt.Errorf("error while sending splunk events: %d %s", res.StatusCode, response.Text)
+	}
+	return nil
+}
+
+// CheckConnection queries the health endpoint of the HTTP Event Collector
+func (options *Options) CheckConnection() error {
+	headers := http.Header{
+		"Authorization": {fmt.Sprintf("Splunk %s", options.SplunkToken)},
+	}
+	return httpreq.NewClient().Ping(strings.TrimSuffix(options.SplunkURL, "/")+healthPath, headers)
+}
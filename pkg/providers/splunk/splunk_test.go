@@ -0,0 +1,73 @@
+package splunk
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestSendBatches(t *testing.T) {
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != eventCollectorPath || r.Header.Get("Authorization") != "Splunk token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"text":"Invalid token","code":4}`))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		_, _ = w.Write([]byte(`{"text":"Success","code":0}`))
+	}))
+	defer server.Close()
+
+	provider, _ := New([]*Options{{
+		ID:                  "splunk",
+		SplunkURL:           server.URL,
+		SplunkToken:         "token",
+		SplunkIndex:         "security",
+		SplunkSourcetype:    "notify",
+		SplunkFlushInterval: time.Hour,
+	}}, nil)
+
+	for _, message := range []string{`{"host":"a.example.com"}`, "plain text"} {
+		if err := provider.Send(message, ""); err != nil {
+			t.Fatalf("could not send message: %s", err)
+		}
+	}
+	if len(bodies) != 0 {
+		t.Fatalf("expected events to be buffered, got %d requests", len(bodies))
+	}
+
+	if err := provider.Close(); err != nil {
+		t.Fatalf("could not close provider: %s", err)
+	}
+	if len(bodies) != 1 {
+		t.Fatalf("expected a single batched request, got %d", len(bodies))
+	}
+
+	lines := bytes.Split(bodies[0], []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 events in the batch, got %q", bodies[0])
+	}
+	var events []Event
+	for _, line := range lines {
+		var event Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			t.Fatalf("invalid event %q: %s", line, err)
+		}
+		if event.Index != "security" || event.Sourcetype != "notify" || event.Time == 0 {
+			t.Errorf("unexpected event metadata %+v", event)
+		}
+		events = append(events, event)
+	}
+	if string(events[0].Event) != `{"host":"a.example.com"}` {
+		t.Errorf("expected the JSON message to stay structured, got %s", events[0].Event)
+	}
+	if string(events[1].Event) != `"plain text"` {
+		t.Errorf("expected the text message as a string, got %s", events[1].Event)
+	}
+}
@@ -0,0 +1,17 @@
+package splunk
+
+import "encoding/json"
+
+type Event struct {
+	Time       float64         `json:"time"`
+	Host       string          `json:"host,omitempty"`
+	Source     string          `json:"source,omitempty"`
+	Sourcetype string          `json:"sourcetype,omitempty"`
+	Index      string          `json:"index,omitempty"`
+	Event      json.RawMessage `json:"event"`
+}
+
+type APIResponse struct {
+	Text string `json:"text,omitempty"`
+	Code int    `json:"code"`
+}
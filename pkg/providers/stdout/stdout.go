@@ -0,0 +1,110 @@
+package stdout
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	Stdout  []*Options `yaml:"stdout,omitempty"`
+	counter int
+	writer  io.Writer
+}
+
+type Options struct {
+	ID           string `yaml:"id,omitempty"`
+	StdoutFormat string `yaml:"stdout_format,omitempty"`
+}
+
+// Delivery is the JSON line written for each notification
+type Delivery struct {
+	Timestamp time.Time       `json:"timestamp"`
+	ID        string          `json:"id"`
+	Count     int             `json:"count"`
+	Message   string          `json:"message"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{writer: os.Stdout}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.Stdout = append(provider.Stdout, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var StdoutErr error
+	p.counter++
+	for _, pr := range p.Stdout {
+		line, err := pr.delivery(message, CliFormat, p.counter)
+		if err == nil {
+			_, err = fmt.Fprintln(p.writer, string(line))
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send stdout notification for id: %s ", pr.ID))
+			StdoutErr = multierr.Append(StdoutErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("stdout notification sent for id: %s", pr.ID)
+	}
+	return StdoutErr
+}
+
+// DryRun writes the JSON lines that would be printed
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var StdoutErr error
+	p.counter++
+	for _, pr := range p.Stdout {
+		line, err := pr.delivery(message, CliFormat, p.counter)
+		if err == nil {
+			err = utils.WriteDryRun(w, pr.ID, string(line))
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run stdout notification for id: %s ", pr.ID))
+			StdoutErr = multierr.Append(StdoutErr, err)
+		}
+	}
+	return StdoutErr
+}
+
+// delivery returns the JSON line of the notification
+func (options *Options) delivery(message, CliFormat string, counter int) ([]byte, error) {
+	msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, options.StdoutFormat), counter)
+
+	delivery := &Delivery{
+		Timestamp: time.Now(),
+		ID:        options.ID,
+		Count:     counter,
+		Message:   msg,
+	}
+	if json.Valid([]byte(message)) {
+		delivery.Data = json.RawMessage(message)
+	}
+	return json.Marshal(delivery)
+}
+
+// HealthCheck always succeeds as writing to stdout needs no connectivity
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Stdout {
+		results = append(results, utils.CheckHealth(pr.ID, false, func() error { return nil }))
+	}
+	return results
+}
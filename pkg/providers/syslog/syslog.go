@@ -0,0 +1,213 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const (
+	defaultAppName = "notify"
+	dialTimeout    = 10 * time.Second
+)
+
+type Provider struct {
+	Syslog  []*Options `yaml:"syslog,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID                string `yaml:"id,omitempty"`
+	SyslogNetwork     string `yaml:"syslog_network,omitempty"`
+	SyslogAddress     string `yaml:"syslog_address,omitempty"`
+	SyslogFacility    string `yaml:"syslog_facility,omitempty"`
+	SyslogSeverity    string `yaml:"syslog_severity,omitempty"`
+	SyslogAppName     string `yaml:"syslog_app_name,omitempty"`
+	SyslogHostname    string `yaml:"syslog_hostname,omitempty"`
+	SyslogMsgID       string `yaml:"syslog_msg_id,omitempty"`
+	SyslogTLSCA       string `yaml:"syslog_tls_ca,omitempty"`
+	SyslogTLSCert     string `yaml:"syslog_tls_cert,omitempty"`
+	SyslogTLSKey      string `yaml:"syslog_tls_key,omitempty"`
+	SyslogTLSInsecure bool   `yaml:"syslog_tls_insecure,omitempty"`
+	SyslogFormat      string `yaml:"syslog_format,omitempty"`
+
+	conn net.Conn
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			// the network is validated regardless of case
+			o.SyslogNetwork = strings.ToLower(o.SyslogNetwork)
+			if o.SyslogNetwork == "" {
+				o.SyslogNetwork = "udp"
+			}
+			if o.SyslogAddress == "" {
+				o.SyslogAddress = "localhost:514"
+				if o.SyslogNetwork == "tls" {
+					o.SyslogAddress = "localhost:6514"
+				}
+			}
+			if o.SyslogAppName == "" {
+				o.SyslogAppName = defaultAppName
+			}
+			if o.SyslogHostname == "" {
+				o.SyslogHostname, _ = os.Hostname()
+			}
+			provider.Syslog = append(provider.Syslog, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var SyslogErr error
+	p.counter++
+	for _, pr := range p.Syslog {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.SyslogFormat), p.counter)
+
+		if err := pr.send(msg, message, p.counter); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send syslog notification for id: %s ", pr.ID))
+			SyslogErr = multierr.Append(SyslogErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("syslog notification sent for id: %s", pr.ID)
+	}
+	return SyslogErr
+}
+
+// DryRun writes the syslog messages that would be sent
+func (p *Provider) DryRun(message, CliFormat string, w io.Writer) error {
+	var SyslogErr error
+	p.counter++
+	for _, pr := range p.Syslog {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.SyslogFormat), p.counter)
+
+		line, err := pr.frame(msg, message, p.counter)
+		if err == nil {
+			err = utils.WriteDryRun(w, pr.ID, fmt.Sprintf("%s://%s\n%s", pr.SyslogNetwork, pr.SyslogAddress, line))
+		}
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to dry run syslog notification for id: %s ", pr.ID))
+			SyslogErr = multierr.Append(SyslogErr, err)
+		}
+	}
+	return SyslogErr
+}
+
+func (options *Options) send(msg, rawMessage string, counter int) error {
+	line, err := options.frame(msg, rawMessage, counter)
+	if err != nil {
+		return err
+	}
+
+	// retry once on a fresh connection in case the previous one was dropped
+	if err := options.write(line); err != nil {
+		options.closeConn()
+		return options.write(line)
+	}
+	return nil
+}
+
+// frame returns the syslog message as written on the connection
+func (options *Options) frame(msg, rawMessage string, counter int) (string, error) {
+	facility, err := parseFacility(options.SyslogFacility)
+	if err != nil {
+		return "", err
+	}
+	severity, err := utils.RenderTemplate(options.SyslogSeverity, rawMessage, counter)
+	if err != nil {
+		return "", err
+	}
+	severityCode, err := parseSeverity(severity)
+	if err != nil {
+		return "", err
+	}
+	msgID, err := utils.RenderTemplate(options.SyslogMsgID, rawMessage, counter)
+	if err != nil {
+		return "", err
+	}
+
+	line := formatMessage(facility, severityCode, time.Now(), options.SyslogHostname, options.SyslogAppName, msgID, msg)
+	if options.SyslogNetwork != "udp" {
+		// octet counting framing (RFC 6587) for stream transports
+		line = fmt.Sprintf("%d %s", len(line), line)
+	}
+	return line, nil
+}
+
+func (options *Options) write(line string) error {
+	if options.conn == nil {
+		if err := options.dial(); err != nil {
+			return err
+		}
+	}
+	_, err := options.conn.Write([]byte(line))
+	return err
+}
+
+func (options *Options) dial() error {
+	var err error
+	switch options.SyslogNetwork {
+	case "udp", "tcp":
+		options.conn, err = net.DialTimeout(options.SyslogNetwork, options.SyslogAddress, dialTimeout)
+	case "tls":
+		var config *tls.Config
+		config, err = utils.NewTLSConfig(options.SyslogTLSCA, options.SyslogTLSCert, options.SyslogTLSKey, options.SyslogTLSInsecure)
+		if err != nil {
+			return err
+		}
+		options.conn, err = tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", options.SyslogAddress, config)
+	default:
+		err = fmt.Errorf("invalid syslog network %q, expected udp, tcp or tls", options.SyslogNetwork)
+	}
+	return err
+}
+
+func (options *Options) closeConn() {
+	if options.conn != nil {
+		_ = options.conn.Close()
+		options.conn = nil
+	}
+}
+
+// Close closes the open connections
+func (p *Provider) Close() error {
+	for _, pr := range p.Syslog {
+		pr.closeConn()
+	}
+	return nil
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := utils.OneOf("syslog_network", options.SyslogNetwork, "udp", "tcp", "tls")
+	if _, facilityErr := parseFacility(options.SyslogFacility); facilityErr != nil {
+		err = multierr.Append(err, utils.NewFieldError("syslog_facility", "%v", facilityErr))
+	}
+	if !strings.Contains(options.SyslogSeverity, "{{") {
+		if _, severityErr := parseSeverity(options.SyslogSeverity); severityErr != nil {
+			err = multierr.Append(err, utils.NewFieldError("syslog_severity", "%v", severityErr))
+		}
+	}
+	if (options.SyslogTLSCert == "") != (options.SyslogTLSKey == "") {
+		err = multierr.Append(err, utils.NewFieldError("syslog_tls_key", "syslog_tls_cert and syslog_tls_key must be set together"))
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package syslog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// nilValue is the RFC 5424 NILVALUE for empty header fields
+const nilValue = "-"
+
+// timestampLayout keeps the 6 fractional digits allowed by the RFC 5424 TIMESTAMP
+const timestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+var facilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11, "ntp": 12, "security": 13, "console": 14,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// severities maps both syslog and common finding severities to syslog severity codes
+var severities = map[string]int{
+	"emerg": 0, "alert": 1, "crit": 2, "err": 3, "warning": 4, "notice": 5, "info": 6, "debug": 7,
+	"emergency": 0, "error": 3, "warn": 4,
+	"critical": 2, "high": 3, "medium": 4, "low": 5, "unknown": 5,
+}
+
+func parseFacility(value string) (int, error) {
+	if value == "" {
+		return facilities["user"], nil
+	}
+	facility, ok := facilities[strings.ToLower(value)]
+	if !ok {
+		return 0, fmt.Errorf("invalid syslog facility %q", value)
+	}
+	return facility, nil
+}
+
+func parseSeverity(value string) (int, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return severities["notice"], nil
+	}
+	severity, ok := severities[value]
+	if !ok {
+		return 0, fmt.Errorf("invalid syslog severity %q", value)
+	}
+	return severity, nil
+}
+
+// headerValue returns a printable header field limited to the RFC 5424 maximum length
+func headerValue(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if value == "" {
+		return nilValue
+	}
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	return value
+}
+
+// formatMessage returns the RFC 5424 representation of the message
+func formatMessage(facility, severity int, ts time.Time, hostname, appName, msgID, message string) string {
+	return fmt.Sprintf("<%d>1 %s %s %s %s %s %s %s",
+		facility*8+severity,
+		ts.Format(timestampLayout),
+		headerValue(hostname, 255),
+		headerValue(appName, 48),
+		headerValue(fmt.Sprint(os.Getpid()), 128),
+		headerValue(msgID, 32),
+		nilValue,
+		message,
+	)
+}
@@ -0,0 +1,62 @@
+package syslog
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+var reRFC5424 = regexp.MustCompile(`^<(\d+)>1 \d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\.\d{6}(?:Z|[+-]\d{2}:\d{2}) host notify \d+ templateid - (.*)$`)
+
+func TestSendUDP(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("could not listen: %s", err)
+	}
+	defer listener.Close()
+
+	provider, _ := New([]*Options{{
+		ID:             "syslog",
+		SyslogAddress:  listener.LocalAddr().String(),
+		SyslogNetwork:  "UDP",
+		SyslogFacility: "local0",
+		SyslogSeverity: "{{ .severity }}",
+		SyslogHostname: "host",
+		SyslogMsgID:    "template id",
+	}}, nil)
+	defer provider.Close()
+
+	message := `{"severity":"critical"}`
+	if err := provider.Send(message, ""); err != nil {
+		t.Fatalf("could not send message: %s", err)
+	}
+
+	buf := make([]byte, 1024)
+	_ = listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := listener.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("could not read message: %s", err)
+	}
+
+	matches := reRFC5424.FindStringSubmatch(string(buf[:n]))
+	if matches == nil {
+		t.Fatalf("message is not RFC 5424 formatted: %s", buf[:n])
+	}
+	// local0 (16) * 8 + crit (2)
+	if matches[1] != "130" {
+		t.Errorf("expected priority 130, got %s", matches[1])
+	}
+	if matches[2] != message {
+		t.Errorf("unexpected message %q", matches[2])
+	}
+}
+
+func TestFormatMessageTimestamp(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 10, 20, 30, 123456789, time.UTC)
+	msg := formatMessage(16, 6, ts, "host", "notify", "", "hello")
+	if !strings.HasPrefix(msg, "<134>1 2024-05-01T10:20:30.123456Z host notify ") {
+		t.Errorf("expected a timestamp with microseconds, got %q", msg)
+	}
+}
@@ -61,3 +61,14 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return TeamsErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	if err := utils.Required("teams_webhook_url", options.TeamsWebHookURL); err != nil {
+		return err
+	}
+	if len(strings.Split(options.TeamsWebHookURL, "/webhookb2/")) != 2 {
+		return utils.NewFieldError("teams_webhook_url", "expected an incoming webhook url containing /webhookb2/")
+	}
+	return nil
+}
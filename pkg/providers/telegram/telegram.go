@@ -58,3 +58,21 @@ func (p *Provider) Send(message, CliFormat string) error {
 	}
 	return TelegramErr
 }
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("telegram_api_key", options.TelegramAPIKey),
+		utils.Required("telegram_chat_id", options.TelegramChatID),
+		utils.OneOf("telegram_parsemode", options.TelegramParseMode, "None", "Markdown", "HTML", "MarkdownV2"),
+	)
+}
+
+// HealthCheck calls getMe and getChat to check the bot token and chat
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Telegram {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
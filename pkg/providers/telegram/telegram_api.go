@@ -0,0 +1,28 @@
+package telegram
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const botAPIURL = "https://api.telegram.org/bot"
+
+// CheckConnection checks the bot token and its access to the chat without posting a message
+func (options *Options) CheckConnection() error {
+	// the chat id may carry a topic id as chat:topic
+	chatID, _, _ := strings.Cut(options.TelegramChatID, ":")
+	for _, method := range []string{"getMe", "getChat?chat_id=" + url.QueryEscape(chatID)} {
+		var response *APIResponse
+		if err := httpreq.NewClient().Get(botAPIURL+options.TelegramAPIKey+"/"+method, &response); err != nil {
+			return err
+		}
+		if !response.Ok {
+			name, _, _ := strings.Cut(method, "?")
+			return fmt.Errorf("telegram %s failed: %s", name, response.Description)
+		}
+	}
+	return nil
+}
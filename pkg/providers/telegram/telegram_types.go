@@ -0,0 +1,6 @@
+package telegram
+
+type APIResponse struct {
+	Ok          bool   `json:"ok"`
+	Description string `json:"description,omitempty"`
+}
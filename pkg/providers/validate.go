@@ -0,0 +1,311 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+// ValidationError is a provider config problem along with its position in the file
+type ValidationError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	switch {
+	case e.Line == 0:
+		return e.Message
+	case e.Column == 0:
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// validator is implemented by provider options able to check themselves
+type validator interface {
+	Validate() error
+}
+
+var reYAMLErrorLine = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// Validate strictly decodes the provider config, rejecting unknown keys and
+// duplicate ids, resolves the secret references and runs the checks of every
+// configured provider
+func Validate(data []byte) []*ValidationError {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return yamlErrors(err, "")
+	}
+	if len(document.Content) == 0 {
+		return []*ValidationError{{Message: "provider config is empty"}}
+	}
+	root := resolveAlias(document.Content[0])
+	if root.Kind != yaml.MappingNode {
+		return []*ValidationError{nodeError(root, "expected a mapping of providers")}
+	}
+
+	var errs []*ValidationError
+	secrets, err := utils.ResolveSecretRefs(root)
+	utils.DefaultRedactor.Add(secrets...)
+	for _, err := range multierr.Errors(err) {
+		var refErr *utils.SecretRefError
+		if errors.As(err, &refErr) {
+			errs = append(errs, &ValidationError{Line: refErr.Line, Column: refErr.Column, Message: refErr.Message()})
+		}
+	}
+
+	providerFields := yamlFields(reflect.TypeOf(ProviderOptions{}))
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], resolveAlias(root.Content[i+1])
+		field, ok := providerFields[key.Value]
+		if !ok {
+			errs = append(errs, nodeError(key, fmt.Sprintf("unknown provider %q", key.Value)))
+			continue
+		}
+		if field.Type.Kind() != reflect.Slice || field.Type.Elem().Kind() != reflect.Pointer {
+			// include, profiles and rate limits
+			errs = append(errs, checkNode(value, field.Type, key.Value)...)
+			if key.Value == "rate_limits" {
+				errs = append(errs, validateRateLimits(value)...)
+			}
+			continue
+		}
+		errs = append(errs, validateProvider(key.Value, value, field.Type)...)
+	}
+	return errs
+}
+
+// validateProvider validates the list of options of a provider
+func validateProvider(name string, node *yaml.Node, listType reflect.Type) []*ValidationError {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return nil
+	}
+	if node.Kind != yaml.SequenceNode {
+		return []*ValidationError{nodeError(node, fmt.Sprintf("%s: expected a list of options", name))}
+	}
+
+	var errs []*ValidationError
+	ids := make(map[string]int)
+	optionsType := listType.Elem().Elem()
+	for _, item := range node.Content {
+		item = resolveAlias(item)
+		prefix := name
+		if id := mappingValue(item, "id"); id != nil && id.Value != "" {
+			prefix = fmt.Sprintf("%s[id=%s]", name, id.Value)
+			if line, ok := ids[id.Value]; ok {
+				errs = append(errs, nodeError(id, fmt.Sprintf("%s: duplicate id, first defined at line %d", name, line)))
+			} else {
+				ids[id.Value] = id.Line
+			}
+		}
+
+		structureErrs := checkNode(item, optionsType, prefix)
+		errs = append(errs, structureErrs...)
+
+		options := reflect.New(optionsType)
+		if err := item.Decode(options.Interface()); err != nil {
+			errs = append(errs, yamlErrors(err, prefix)...)
+			continue
+		}
+		if len(structureErrs) > 0 {
+			continue
+		}
+		v, ok := options.Interface().(validator)
+		if !ok {
+			continue
+		}
+		for _, err := range multierr.Errors(v.Validate()) {
+			var fieldErr *utils.FieldError
+			if errors.As(err, &fieldErr) {
+				position := item
+				if key := mappingKey(item, fieldErr.Field); key != nil {
+					position = key
+				}
+				errs = append(errs, nodeError(position, fmt.Sprintf("%s: %s", prefix, fieldErr)))
+				continue
+			}
+			errs = append(errs, nodeError(item, fmt.Sprintf("%s: %s", prefix, err)))
+		}
+	}
+	return errs
+}
+
+// validateRateLimits checks the rate limits are set for known providers
+// and allow some notifications
+func validateRateLimits(node *yaml.Node) []*ValidationError {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	names := ProviderNames()
+	var errs []*ValidationError
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], resolveAlias(node.Content[i+1])
+		if !sliceutil.Contains(names, key.Value) {
+			errs = append(errs, nodeError(key, fmt.Sprintf("rate_limits: unknown provider %q", key.Value)))
+			continue
+		}
+		prefix := "rate_limits." + key.Value
+		var limit ProviderRateLimit
+		if err := value.Decode(&limit); err != nil {
+			errs = append(errs, yamlErrors(err, prefix)...)
+			continue
+		}
+		for _, name := range []string{"provider", "id"} {
+			limitNode := mappingValue(value, name)
+			errs = append(errs, validateRateLimit(limitNode, prefix+"."+name)...)
+		}
+		if ids := mappingValue(value, "ids"); ids != nil && ids.Kind == yaml.MappingNode {
+			for j := 0; j+1 < len(ids.Content); j += 2 {
+				errs = append(errs, validateRateLimit(resolveAlias(ids.Content[j+1]), prefix+".ids."+ids.Content[j].Value)...)
+			}
+		}
+	}
+	return errs
+}
+
+func validateRateLimit(node *yaml.Node, prefix string) []*ValidationError {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	var limit RateLimit
+	if err := node.Decode(&limit); err != nil {
+		return nil
+	}
+	if err := limit.Validate(); err != nil {
+		return []*ValidationError{nodeError(node, fmt.Sprintf("%s: %s", prefix, err))}
+	}
+	return nil
+}
+
+// checkNode reports unknown keys and mismatched node kinds against the Go type
+func checkNode(node *yaml.Node, t reflect.Type, prefix string) []*ValidationError {
+	node = resolveAlias(node)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return nil
+	}
+
+	var errs []*ValidationError
+	switch t.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return []*ValidationError{nodeError(node, fmt.Sprintf("%s: expected a mapping", prefix))}
+		}
+		fields := yamlFields(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			field, ok := fields[key.Value]
+			if !ok {
+				errs = append(errs, nodeError(key, fmt.Sprintf("%s: unknown option %q", prefix, key.Value)))
+				continue
+			}
+			errs = append(errs, checkNode(node.Content[i+1], field.Type, prefix)...)
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			return []*ValidationError{nodeError(node, fmt.Sprintf("%s: expected a list", prefix))}
+		}
+		for _, item := range node.Content {
+			errs = append(errs, checkNode(item, t.Elem(), prefix)...)
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return []*ValidationError{nodeError(node, fmt.Sprintf("%s: expected a mapping", prefix))}
+		}
+		for i := 1; i < len(node.Content); i += 2 {
+			errs = append(errs, checkNode(node.Content[i], t.Elem(), prefix)...)
+		}
+	}
+	return errs
+}
+
+// yamlFields returns the exported fields of the struct keyed by yaml name
+func yamlFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		fields[name] = field
+	}
+	return fields
+}
+
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return resolveAlias(node.Content[i+1])
+		}
+	}
+	return nil
+}
+
+func resolveAlias(node *yaml.Node) *yaml.Node {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+func nodeError(node *yaml.Node, message string) *ValidationError {
+	return &ValidationError{Line: node.Line, Column: node.Column, Message: message}
+}
+
+// yamlErrors converts yaml decoding errors, which embed the line in their message
+func yamlErrors(err error, prefix string) []*ValidationError {
+	messages := []string{err.Error()}
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		messages = typeErr.Errors
+	}
+
+	var errs []*ValidationError
+	for _, message := range messages {
+		validationErr := &ValidationError{Message: message}
+		if match := reYAMLErrorLine.FindStringSubmatch(message); match != nil {
+			validationErr.Line, _ = strconv.Atoi(match[1])
+			validationErr.Message = match[2]
+		}
+		if prefix != "" {
+			validationErr.Message = fmt.Sprintf("%s: %s", prefix, validationErr.Message)
+		}
+		errs = append(errs, validationErr)
+	}
+	return errs
+}
@@ -0,0 +1,96 @@
+package providers
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	config := `slack:
+  - id: "team"
+    slack_webhook_url: "https://hooks.slack.com/services/XXX/YYY/ZZZ"
+  - id: "team"
+    slack_threads: true
+    slack_token: "xoxb-XXX"
+    slack_channel: "alerts"
+teams:
+  - id: "team"
+    teams_webhook_url: "https://example.webhook.office.com/XXX"
+discord:
+  - id: "discord"
+    discord_webhook_url: "https://discord.com/api/webhooks/1/token"
+    discord_usernme: "notify"
+ntfy:
+  - id: "ntfy"
+    ntfy_topic: "alerts"
+    ntfy_priority: "loud"
+file:
+  - id: "file"
+    file_path: "/tmp/notify.log"
+    file_max_size_mb: lots
+telegrm:
+  - id: "telegram"
+rate_limits:
+  slack:
+    id:
+      requests: 0
+      per: 1s
+  discrod:
+    id:
+      requests: 1
+      per: 1s
+`
+	expected := []string{
+		`line 4, column 9: slack: duplicate id, first defined at line 2`,
+		`line 10, column 5: teams[id=team]: teams_webhook_url: expected an incoming webhook url containing /webhookb2/`,
+		`line 14, column 5: discord[id=discord]: unknown option "discord_usernme"`,
+		`line 18, column 5: ntfy[id=ntfy]: ntfy_priority: invalid ntfy priority "loud"`,
+		"line 22: file[id=file]: cannot unmarshal !!str `lots` into int",
+		`line 23, column 1: unknown provider "telegrm"`,
+		`line 28, column 7: rate_limits.slack.id: requests must be greater than 0`,
+		`line 30, column 3: rate_limits: unknown provider "discrod"`,
+	}
+
+	errs := Validate([]byte(config))
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, err := range errs {
+		if err.Error() != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], err.Error())
+		}
+	}
+
+	valid := `slack:
+  - id: "team"
+    slack_webhook_url: "https://hooks.slack.com/services/XXX/YYY/ZZZ"
+custom:
+  - id: "webhook"
+    custom_webhook_url: 'https://example.com/{{ .host }}'
+    custom_success_status: ["2xx", 409]
+`
+	if errs := Validate([]byte(valid)); len(errs) != 0 {
+		t.Errorf("expected a valid config, got %v", errs)
+	}
+}
+
+// TestJSONSchema keeps the published schema in sync with the provider options,
+// regenerate it with: notify -config-schema > static/provider-config.schema.json
+func TestJSONSchema(t *testing.T) {
+	schema, err := JSONSchema()
+	if err != nil {
+		t.Fatalf("could not generate schema: %s", err)
+	}
+	published, err := os.ReadFile("../../static/provider-config.schema.json")
+	if err != nil {
+		t.Fatalf("could not read published schema: %s", err)
+	}
+	if !bytes.Equal(bytes.TrimSpace(published), schema) {
+		t.Error("static/provider-config.schema.json is outdated")
+	}
+	if !strings.Contains(string(schema), `"slack_webhook_url"`) {
+		t.Error("expected provider options in the schema")
+	}
+}
@@ -0,0 +1,87 @@
+package webex
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	Webex   []*Options `yaml:"webex,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID               string `yaml:"id,omitempty"`
+	WebexToken       string `yaml:"webex_token,omitempty"`
+	WebexRoomID      string `yaml:"webex_room_id,omitempty"`
+	WebexPersonEmail string `yaml:"webex_person_email,omitempty"`
+	WebexMarkdown    bool   `yaml:"webex_markdown,omitempty"`
+	WebexFormat      string `yaml:"webex_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.Webex = append(provider.Webex, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var WebexErr error
+	p.counter++
+	for _, pr := range p.Webex {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.WebexFormat), p.counter)
+
+		if pr.WebexToken == "" {
+			err := errors.Wrap(fmt.Errorf("webex_token value is required"),
+				fmt.Sprintf("failed to send webex notification for id: %s ", pr.ID))
+			WebexErr = multierr.Append(WebexErr, err)
+			continue
+		}
+		if (pr.WebexRoomID == "") == (pr.WebexPersonEmail == "") {
+			err := errors.Wrap(fmt.Errorf("exactly one of webex_room_id or webex_person_email values is required"),
+				fmt.Sprintf("failed to send webex notification for id: %s ", pr.ID))
+			WebexErr = multierr.Append(WebexErr, err)
+			continue
+		}
+
+		if err := pr.SendMessage(msg); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send webex notification for id: %s ", pr.ID))
+			WebexErr = multierr.Append(WebexErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("webex notification sent for id: %s", pr.ID)
+	}
+	return WebexErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := utils.Required("webex_token", options.WebexToken)
+	if (options.WebexRoomID == "") == (options.WebexPersonEmail == "") {
+		err = multierr.Append(err, fmt.Errorf("exactly one of webex_room_id or webex_person_email values is required"))
+	}
+	return err
+}
+
+// HealthCheck checks the bot token with the people/me endpoint
+func (p *Provider) HealthCheck() []*utils.HealthCheckResult {
+	var results []*utils.HealthCheckResult
+	for _, pr := range p.Webex {
+		results = append(results, utils.CheckHealth(pr.ID, false, pr.CheckConnection))
+	}
+	return results
+}
@@ -0,0 +1,47 @@
+package webex
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const (
+	MessagesAPI = "https://webexapis.com/v1/messages"
+	PeopleMeAPI = "https://webexapis.com/v1/people/me"
+)
+
+func (options *Options) SendMessage(message string) error {
+	payload := APIRequest{
+		RoomID:        options.WebexRoomID,
+		ToPersonEmail: options.WebexPersonEmail,
+	}
+	if options.WebexMarkdown {
+		payload.Markdown = message
+	} else {
+		payload.Text = message
+	}
+
+	headers := http.Header{
+		"Content-Type":  {"application/json"},
+		"Authorization": {fmt.Sprintf("Bearer %s", options.WebexToken)},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(MessagesAPI, &payload, headers, &response); err != nil {
+		return err
+	}
+	if response.ID == "" {
+		return fmt.Errorf("error while sending webex message: %s ", response.Message)
+	}
+	return nil
+}
+
+// CheckConnection checks the bot token with the people/me endpoint
+func (options *Options) CheckConnection() error {
+	headers := http.Header{
+		"Authorization": {fmt.Sprintf("Bearer %s", options.WebexToken)},
+	}
+	return httpreq.NewClient().Ping(PeopleMeAPI, headers)
+}
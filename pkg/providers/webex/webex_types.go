@@ -0,0 +1,13 @@
+package webex
+
+type APIRequest struct {
+	RoomID        string `json:"roomId,omitempty"`
+	ToPersonEmail string `json:"toPersonEmail,omitempty"`
+	Text          string `json:"text,omitempty"`
+	Markdown      string `json:"markdown,omitempty"`
+}
+
+type APIResponse struct {
+	ID      string `json:"id,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,85 @@
+package wecom
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+type Provider struct {
+	WeCom   []*Options `yaml:"wecom,omitempty"`
+	counter int
+}
+
+// Options for wecom group robots, which are only authenticated by the key in
+// the webhook url. Unlike dingtalk and feishu, wecom offers no signature or
+// keyword security setting, so there are no secret or keyword options.
+type Options struct {
+	ID                       string   `yaml:"id,omitempty"`
+	WeComWebhookURL          string   `yaml:"wecom_webhook_url,omitempty"`
+	WeComMsgType             string   `yaml:"wecom_msg_type,omitempty"`
+	WeComTitle               string   `yaml:"wecom_title,omitempty"`
+	WeComCardURL             string   `yaml:"wecom_card_url,omitempty"`
+	WeComMentionedList       []string `yaml:"wecom_mentioned_list,omitempty"`
+	WeComMentionedMobileList []string `yaml:"wecom_mentioned_mobile_list,omitempty"`
+	WeComAtAll               bool     `yaml:"wecom_at_all,omitempty"`
+	WeComFormat              string   `yaml:"wecom_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			// the message type is validated regardless of case
+			o.WeComMsgType = strings.ToLower(o.WeComMsgType)
+			provider.WeCom = append(provider.WeCom, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var WeComErr error
+	p.counter++
+	for _, pr := range p.WeCom {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.WeComFormat), p.counter)
+
+		payload, err := pr.buildRequest(msg, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to build wecom message for id: %s ", pr.ID))
+			WeComErr = multierr.Append(WeComErr, err)
+			continue
+		}
+
+		if err := pr.SendMessage(payload); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send wecom notification for id: %s ", pr.ID))
+			WeComErr = multierr.Append(WeComErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("wecom notification sent for id: %s", pr.ID)
+	}
+	return WeComErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	err := multierr.Combine(
+		utils.Required("wecom_webhook_url", options.WeComWebhookURL),
+		utils.ValidURL("wecom_webhook_url", options.WeComWebhookURL),
+		utils.OneOf("wecom_msg_type", options.WeComMsgType, "text", "markdown", "card"),
+	)
+	if strings.EqualFold(options.WeComMsgType, "card") {
+		err = multierr.Append(err, utils.Required("wecom_card_url", options.WeComCardURL))
+	}
+	return err
+}
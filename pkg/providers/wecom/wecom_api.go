@@ -0,0 +1,88 @@
+package wecom
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+// error codes returned by the group robot webhook
+const (
+	errCodeInvalidKey = 93000
+	errCodeRateLimit  = 45009
+)
+
+// buildRequest renders the message into the configured message type
+func (options *Options) buildRequest(message, rawMessage string, counter int) (*APIRequest, error) {
+	mentions := options.WeComMentionedList
+	if options.WeComAtAll {
+		mentions = append(append([]string{}, mentions...), "@all")
+	}
+
+	payload := &APIRequest{MsgType: options.WeComMsgType}
+	switch options.WeComMsgType {
+	case "", "text":
+		payload.MsgType = "text"
+		payload.Text = &Text{
+			Content:             message,
+			MentionedList:       mentions,
+			MentionedMobileList: options.WeComMentionedMobileList,
+		}
+	case "markdown":
+		// markdown messages only support mentions by user id inline
+		for _, user := range options.WeComMentionedList {
+			message += fmt.Sprintf(" <@%s>", user)
+		}
+		payload.Markdown = &Markdown{Content: message}
+	case "card":
+		title, err := utils.RenderTemplate(options.WeComTitle, rawMessage, counter)
+		if err != nil {
+			return nil, err
+		}
+		if title = strings.TrimSpace(title); title == "" {
+			title = "notify"
+		}
+		cardURL, err := utils.RenderTemplate(options.WeComCardURL, rawMessage, counter)
+		if err != nil {
+			return nil, err
+		}
+		if cardURL = strings.TrimSpace(cardURL); cardURL == "" {
+			return nil, fmt.Errorf("wecom_card_url value is required for card messages")
+		}
+		payload.MsgType = "template_card"
+		payload.TemplateCard = &TemplateCard{
+			CardType:     "text_notice",
+			MainTitle:    &MainTitle{Title: title},
+			SubTitleText: message,
+			CardAction:   &CardAction{Type: 1, URL: cardURL},
+		}
+	default:
+		return nil, fmt.Errorf("invalid wecom message type %q", options.WeComMsgType)
+	}
+	return payload, nil
+}
+
+func (options *Options) SendMessage(payload *APIRequest) error {
+	headers := http.Header{
+		"Content-Type": {"application/json"},
+	}
+
+	var response *APIResponse
+	if err := httpreq.NewClient().Post(options.WeComWebhookURL, payload, headers, &response); err != nil {
+		return err
+	}
+
+	switch response.ErrCode {
+	case 0:
+		return nil
+	case errCodeInvalidKey:
+		return fmt.Errorf("invalid robot webhook key, check wecom_webhook_url: %s", response.ErrMsg)
+	case errCodeRateLimit:
+		return fmt.Errorf("robot rate limit of 20 messages per minute exceeded: %s", response.ErrMsg)
+	default:
+		return fmt.Errorf("error while sending wecom message: %d %s", response.ErrCode, response.ErrMsg)
+	}
+}
@@ -0,0 +1,40 @@
+package wecom
+
+type APIRequest struct {
+	MsgType      string        `json:"msgtype"`
+	Text         *Text         `json:"text,omitempty"`
+	Markdown     *Markdown     `json:"markdown,omitempty"`
+	TemplateCard *TemplateCard `json:"template_card,omitempty"`
+}
+
+type Text struct {
+	Content             string   `json:"content"`
+	MentionedList       []string `json:"mentioned_list,omitempty"`
+	MentionedMobileList []string `json:"mentioned_mobile_list,omitempty"`
+}
+
+type Markdown struct {
+	Content string `json:"content"`
+}
+
+type TemplateCard struct {
+	CardType     string      `json:"card_type"`
+	MainTitle    *MainTitle  `json:"main_title"`
+	SubTitleText string      `json:"sub_title_text,omitempty"`
+	CardAction   *CardAction `json:"card_action"`
+}
+
+type MainTitle struct {
+	Title string `json:"title,omitempty"`
+	Desc  string `json:"desc,omitempty"`
+}
+
+type CardAction struct {
+	Type int    `json:"type"`
+	URL  string `json:"url,omitempty"`
+}
+
+type APIResponse struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg,omitempty"`
+}
@@ -0,0 +1,88 @@
+package zulip
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/projectdiscovery/gologger"
+	"github.com/projectdiscovery/notify/pkg/utils"
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+const defaultTopic = "notify"
+
+type Provider struct {
+	Zulip   []*Options `yaml:"zulip,omitempty"`
+	counter int
+}
+
+type Options struct {
+	ID            string `yaml:"id,omitempty"`
+	ZulipSite     string `yaml:"zulip_site,omitempty"`
+	ZulipBotEmail string `yaml:"zulip_bot_email,omitempty"`
+	ZulipAPIKey   string `yaml:"zulip_api_key,omitempty"`
+	ZulipStream   string `yaml:"zulip_stream,omitempty"`
+	ZulipTopic    string `yaml:"zulip_topic,omitempty"`
+	ZulipFormat   string `yaml:"zulip_format,omitempty"`
+}
+
+func New(options []*Options, ids []string) (*Provider, error) {
+	provider := &Provider{}
+
+	for _, o := range options {
+		if len(ids) == 0 || sliceutil.Contains(ids, o.ID) {
+			provider.Zulip = append(provider.Zulip, o)
+		}
+	}
+
+	provider.counter = 0
+
+	return provider, nil
+}
+
+func (p *Provider) Send(message, CliFormat string) error {
+	var ZulipErr error
+	p.counter++
+	for _, pr := range p.Zulip {
+		msg := utils.FormatMessage(message, utils.SelectFormat(CliFormat, pr.ZulipFormat), p.counter)
+
+		if pr.ZulipSite == "" || pr.ZulipBotEmail == "" || pr.ZulipAPIKey == "" || pr.ZulipStream == "" {
+			err := errors.Wrap(fmt.Errorf("zulip_site, zulip_bot_email, zulip_api_key and zulip_stream values are required"),
+				fmt.Sprintf("failed to send zulip notification for id: %s ", pr.ID))
+			ZulipErr = multierr.Append(ZulipErr, err)
+			continue
+		}
+
+		topic, err := utils.RenderTemplate(pr.ZulipTopic, message, p.counter)
+		if err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to render zulip topic for id: %s ", pr.ID))
+			ZulipErr = multierr.Append(ZulipErr, err)
+			continue
+		}
+		if topic = strings.TrimSpace(topic); topic == "" {
+			topic = defaultTopic
+		}
+
+		if err := pr.SendMessage(topic, msg); err != nil {
+			err = errors.Wrap(err, fmt.Sprintf("failed to send zulip notification for id: %s ", pr.ID))
+			ZulipErr = multierr.Append(ZulipErr, err)
+			continue
+		}
+		gologger.Verbose().Msgf("zulip notification sent for id: %s", pr.ID)
+	}
+	return ZulipErr
+}
+
+// Validate reports configuration mistakes in the options
+func (options *Options) Validate() error {
+	return multierr.Combine(
+		utils.Required("zulip_site", options.ZulipSite),
+		utils.ValidURL("zulip_site", options.ZulipSite),
+		utils.Required("zulip_bot_email", options.ZulipBotEmail),
+		utils.Required("zulip_api_key", options.ZulipAPIKey),
+		utils.Required("zulip_stream", options.ZulipStream),
+	)
+}
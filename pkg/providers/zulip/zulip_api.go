@@ -0,0 +1,43 @@
+package zulip
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/projectdiscovery/notify/pkg/utils"
+	"github.com/projectdiscovery/notify/pkg/utils/httpreq"
+)
+
+const messagesAPIPath = "/api/v1/messages"
+
+// maxTopicLength is the maximum topic length accepted by zulip
+const maxTopicLength = 60
+
+// SendMessage sends a stream message to the given topic
+func (options *Options) SendMessage(topic, message string) error {
+	topic = utils.Truncate(topic, maxTopicLength)
+	values := url.Values{
+		"type":    {"stream"},
+		"to":      {options.ZulipStream},
+		"topic":   {topic},
+		"content": {message},
+	}
+
+	credentials := base64.StdEncoding.EncodeToString([]byte(options.ZulipBotEmail + ":" + options.ZulipAPIKey))
+	headers := http.Header{
+		"Authorization": {fmt.Sprintf("Basic %s", credentials)},
+	}
+
+	var response *APIResponse
+	err := httpreq.NewClient().PostForm(strings.TrimSuffix(options.ZulipSite, "/")+messagesAPIPath, values, headers, &response)
+	if err != nil {
+		return err
+	}
+	if response.Result != "success" {
+		return fmt.Errorf("error while sending zulip message: %s ", response.Msg)
+	}
+	return nil
+}
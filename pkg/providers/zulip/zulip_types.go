@@ -0,0 +1,7 @@
+package zulip
+
+type APIResponse struct {
+	Result string `json:"result,omitempty"`
+	Msg    string `json:"msg,omitempty"`
+	ID     int64  `json:"id,omitempty"`
+}
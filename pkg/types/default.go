@@ -2,4 +2,6 @@ package types
 
 const (
 	DefaultProviderConfigLocation = ".config/notify/provider-config.yaml"
+	// DefaultRateLimit is the -rate-limit used unless the flag or the profile sets it
+	DefaultRateLimit = 1
 )
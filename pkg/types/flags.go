@@ -0,0 +1,41 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/projectdiscovery/goflags"
+)
+
+// RateLimitVar adds the -rate-limit flag, recording whether it was given on
+// the command line or in the config file so that a profile only replaces
+// the default
+func RateLimitVar(set *goflags.FlagSet, options *Options, usage string) *goflags.FlagData {
+	options.RateLimit = DefaultRateLimit
+	return set.VarP(&rateLimitValue{options: options}, "rate-limit", "rl", usage)
+}
+
+type rateLimitValue struct {
+	options *Options
+}
+
+func (v *rateLimitValue) String() string {
+	if v.options == nil {
+		return "0"
+	}
+	return strconv.Itoa(v.options.RateLimit)
+}
+
+func (v *rateLimitValue) Set(value string) error {
+	rateLimit, err := strconv.Atoi(value)
+	if err != nil {
+		return err
+	}
+	v.options.RateLimit = rateLimit
+	v.options.RateLimitSet = true
+	return nil
+}
+
+// MarshalYAML writes the default rate limit to the generated config file
+func (v *rateLimitValue) MarshalYAML() (interface{}, error) {
+	return DefaultRateLimit, nil
+}
@@ -7,12 +7,16 @@ type Options struct {
 	NoColor        bool                `yaml:"no_color,omitempty"`
 	Silent         bool                `yaml:"silent,omitempty"`
 	Version        bool                `yaml:"version,omitempty"`
-	ProviderConfig string              `yaml:"provider_config,omitempty"`
+	ProviderConfig goflags.StringSlice `yaml:"provider_config,omitempty"`
+	Profile        string              `yaml:"profile,omitempty"`
 	Providers      goflags.StringSlice `yaml:"providers,omitempty"`
 	IDs            goflags.StringSlice `yaml:"ids,omitempty"`
+	URLs           goflags.StringSlice `yaml:"urls,omitempty"`
 	Proxy          string              `yaml:"proxy,omitempty"`
 	RateLimit      int                 `yaml:"rate_limit,omitempty"`
-	Delay          int                 `yaml:"delay,omitempty"`
+	// RateLimitSet reports whether -rate-limit was given
+	RateLimitSet bool `yaml:"-"`
+	Delay        int  `yaml:"delay,omitempty"`
 
 	MessageFormat string `yaml:"message_format,omitempty"`
 
@@ -21,4 +25,18 @@ type Options struct {
 	CharLimit          int    `yaml:"char_limit,omitempty"`
 	Data               string `yaml:"data,omitempty"`
 	DisableUpdateCheck bool   `yaml:"disable_update_check,omitempty"`
+
+	DisableConfigReload bool `yaml:"disable_config_reload,omitempty"`
+
+	ValidateConfig bool `yaml:"validate_config,omitempty"`
+	ConfigSchema   bool `yaml:"config_schema,omitempty"`
+	Test           bool `yaml:"test,omitempty"`
+	DryRun         bool `yaml:"dry_run,omitempty"`
+
+	Init      bool   `yaml:"init,omitempty"`
+	ImportURL string `yaml:"import_url,omitempty"`
+
+	EncryptConfig bool   `yaml:"encrypt_config,omitempty"`
+	DecryptConfig bool   `yaml:"decrypt_config,omitempty"`
+	ConfigKeyFile string `yaml:"config_key_file,omitempty"`
 }
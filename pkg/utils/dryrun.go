@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WriteDryRun writes the payload an id would receive in dry run mode
+func WriteDryRun(w io.Writer, id, payload string) error {
+	_, err := fmt.Fprintf(w, "# id: %s\n%s\n\n", id, strings.TrimRight(payload, "\r\n"))
+	return err
+}
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedHeader is the first line of the files encrypted with Encrypt,
+// followed by the base64 of the salt, the nonce and the sealed data
+const encryptedHeader = "notify-encrypted-config/v1"
+
+const (
+	saltSize = 16
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// ErrWrongPassphrase is returned when encrypted data can't be opened
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted file")
+
+// derivedKeys caches the keys derived from a passphrase and a salt, the
+// config files are read again on each reload
+var derivedKeys sync.Map
+
+// IsEncrypted reports whether the data was encrypted with Encrypt
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, []byte(encryptedHeader+"\n"))
+}
+
+// Encrypt seals the data with AES-256-GCM under a key derived from the
+// passphrase with scrypt, and returns it as text safe to store in backups
+func Encrypt(data, passphrase []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := append(append(salt, nonce...), aead.Seal(nil, nonce, data, []byte(encryptedHeader))...)
+	encoded := base64.StdEncoding.EncodeToString(sealed)
+
+	var out bytes.Buffer
+	out.WriteString(encryptedHeader + "\n")
+	for len(encoded) > 76 {
+		out.WriteString(encoded[:76] + "\n")
+		encoded = encoded[76:]
+	}
+	out.WriteString(encoded + "\n")
+	return out.Bytes(), nil
+}
+
+// Decrypt opens data encrypted with Encrypt
+func Decrypt(data, passphrase []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return nil, errors.New("data is not encrypted")
+	}
+	encoded := bytes.Join(bytes.Fields(data[len(encryptedHeader):]), nil)
+	sealed := make([]byte, base64.StdEncoding.DecodedLen(len(encoded)))
+	n, err := base64.StdEncoding.Decode(sealed, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encrypted data: %w", err)
+	}
+	sealed = sealed[:n]
+
+	if len(sealed) < saltSize {
+		return nil, ErrWrongPassphrase
+	}
+	aead, err := newAEAD(passphrase, sealed[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+	sealed = sealed[saltSize:]
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(encryptedHeader))
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	return plaintext, nil
+}
+
+func newAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	if len(passphrase) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	digest := sha256.Sum256(passphrase)
+	cacheKey := string(digest[:]) + string(salt)
+
+	key, ok := derivedKeys.Load(cacheKey)
+	if !ok {
+		derived, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, 32)
+		if err != nil {
+			return nil, err
+		}
+		key, _ = derivedKeys.LoadOrStore(cacheKey, derived)
+	}
+	block, err := aes.NewCipher(key.([]byte))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
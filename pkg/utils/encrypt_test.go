@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestEncrypt(t *testing.T) {
+	config := []byte("slack:\n  - id: team\n    slack_webhook_url: https://hooks.slack.com/services/T0/B0/XXXX\n")
+	encrypted, err := Encrypt(config, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(encrypted) || IsEncrypted(config) {
+		t.Error("unexpected IsEncrypted result")
+	}
+	if bytes.Contains(encrypted, []byte("hooks.slack.com")) {
+		t.Errorf("plaintext found in:\n%s", encrypted)
+	}
+	for _, line := range strings.Split(string(encrypted), "\n") {
+		if len(line) > 76 {
+			t.Errorf("line longer than 76 characters: %s", line)
+		}
+	}
+
+	decrypted, err := Decrypt(encrypted, []byte("passphrase"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(decrypted, config) {
+		t.Errorf("expected %q, got %q", config, decrypted)
+	}
+	if _, err := Decrypt(encrypted, []byte("wrong")); !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("expected a wrong passphrase error, got %v", err)
+	}
+
+	tampered := bytes.Replace(encrypted, encrypted[40:41], []byte{encrypted[40] ^ 1}, 1)
+	if _, err := Decrypt(tampered, []byte("passphrase")); err == nil {
+		t.Error("expected tampered data to be rejected")
+	}
+}
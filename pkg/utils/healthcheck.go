@@ -0,0 +1,23 @@
+package utils
+
+import "time"
+
+// TestMessage is posted by the connectivity self-test to destinations
+// without a read-only health check
+const TestMessage = "[notify test] connectivity check from notify -test, please ignore"
+
+// HealthCheckResult is the outcome of the connectivity self-test of one id
+type HealthCheckResult struct {
+	ID string
+	// Sent reports that a test message was posted
+	Sent    bool
+	Latency time.Duration
+	Err     error
+}
+
+// CheckHealth runs the check of an id and measures its latency
+func CheckHealth(id string, sent bool, check func() error) *HealthCheckResult {
+	start := time.Now()
+	err := check()
+	return &HealthCheckResult{ID: id, Sent: sent, Latency: time.Since(start), Err: err}
+}
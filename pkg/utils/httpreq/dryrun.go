@@ -0,0 +1,108 @@
+package httpreq
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"strings"
+	"sync"
+)
+
+// dryRunResponse is the body answered to every request of a dry run, it
+// satisfies the providers checking an "ok" field or reading a token or an
+// id from the response to build their next request
+const dryRunResponse = `{"ok":true,"access_token":"dry-run","room_id":"!dry-run","event_id":"$dry-run"}`
+
+// DryRunTransport writes the requests to a writer instead of sending them
+// and answers each with an empty successful response
+type DryRunTransport struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewDryRunTransport returns a transport writing the requests to w
+func NewDryRunTransport(w io.Writer) *DryRunTransport {
+	return &DryRunTransport{writer: w}
+}
+
+// RoundTrip writes the request and returns a fake successful response
+func (t *DryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if err := DumpRequest(t.writer, req); err != nil {
+		return nil, err
+	}
+	status := dryRunStatus(req)
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(strings.NewReader(dryRunResponse)),
+		ContentLength: int64(len(dryRunResponse)),
+		Request:       req,
+	}, nil
+}
+
+// dryRunStatus returns the status the destination answers on success,
+// discord webhooks reply with no content and shoutrrr rejects any other
+func dryRunStatus(req *http.Request) int {
+	if strings.Contains(req.URL.Hostname(), "discord") && strings.Contains(req.URL.Path, "/webhooks/") {
+		return http.StatusNoContent
+	}
+	return http.StatusOK
+}
+
+// DumpRequest writes the request as it would be sent on the wire, with the
+// credentials of authorization headers masked. The request body is consumed.
+func DumpRequest(w io.Writer, req *http.Request) error {
+	masked := req.Clone(req.Context())
+	if masked.URL.User != nil {
+		// the transport turns url credentials into basic authentication
+		if masked.Header.Get("Authorization") == "" {
+			masked.Header.Set("Authorization", "Basic ****")
+		}
+		masked.URL.User = nil
+	}
+	for name, values := range masked.Header {
+		if !isCredentialHeader(name) {
+			continue
+		}
+		for i, value := range values {
+			values[i] = maskCredential(value)
+		}
+	}
+
+	dump, err := httputil.DumpRequestOut(masked, true)
+	if err != nil {
+		return fmt.Errorf("could not dump request: %v", err)
+	}
+	_, err = fmt.Fprintf(w, "%s\n\n", strings.TrimRight(string(dump), "\r\n"))
+	return err
+}
+
+func isCredentialHeader(name string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "authorization", "proxy-authorization", "cookie":
+		return true
+	}
+	for _, word := range []string{"token", "api-key", "apikey", "secret", "password"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskCredential keeps the scheme of authorization values such as "Bearer"
+func maskCredential(value string) string {
+	if scheme, _, ok := strings.Cut(value, " "); ok {
+		return scheme + " ****"
+	}
+	return "****"
+}
@@ -2,9 +2,13 @@ package httpreq
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	neturl "net/url"
+	"strings"
 
 	jsoniter "github.com/json-iterator/go"
 )
@@ -31,13 +35,66 @@ func (c *Client) Get(url string, response interface{}) error {
 	return nil
 }
 
+func (c *Client) GetWithHeaders(url string, headers http.Header, response interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	for key, val := range headers {
+		req.Header.Set(key, val[0])
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err = jsoniter.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error trying to unmarshal the response: %v", err)
+	}
+	return nil
+}
+
 func (c *Client) Post(url string, requestBody interface{}, headers http.Header, response interface{}) error {
+	return c.sendJSON(http.MethodPost, url, requestBody, headers, response)
+}
+
+func (c *Client) PostForm(url string, values neturl.Values, headers http.Header, response interface{}) error {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(values.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	for key, val := range headers {
+		req.Header.Set(key, val[0])
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send payload: %v", err)
+	}
+	defer res.Body.Close()
+
+	if err = jsoniter.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("error trying to unmarshal the response: %v", err)
+	}
+	return nil
+}
+
+func (c *Client) Put(url string, requestBody interface{}, headers http.Header, response interface{}) error {
+	return c.sendJSON(http.MethodPut, url, requestBody, headers, response)
+}
+
+func (c *Client) sendJSON(method, url string, requestBody interface{}, headers http.Header, response interface{}) error {
 	body, err := json.Marshal(requestBody)
 	if err != nil {
 		return fmt.Errorf("error creating payload: %v", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
 	if err != nil {
 		return fmt.Errorf("error creating request: %v", err)
 	}
@@ -50,6 +107,7 @@ func (c *Client) Post(url string, requestBody interface{}, headers http.Header,
 	if err != nil {
 		return fmt.Errorf("failed to send payload: %v", err)
 	}
+	defer res.Body.Close()
 
 	if err = jsoniter.NewDecoder(res.Body).Decode(&response); err != nil {
 		return fmt.Errorf("error trying to unmarshal the response: %v", err)
@@ -60,3 +118,44 @@ func (c *Client) Post(url string, requestBody interface{}, headers http.Header,
 func (c *Client) Do(req *http.Request) (*http.Response, error) {
 	return c.httpClient.Do(req)
 }
+
+// NewTLSClient returns a client presenting the given TLS configuration
+// while keeping the proxy settings of the default transport
+func NewTLSClient(tlsConfig *tls.Config) *Client {
+	if dryRun, ok := http.DefaultClient.Transport.(*DryRunTransport); ok {
+		return &Client{httpClient: &http.Client{Transport: dryRun}}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if base, ok := http.DefaultClient.Transport.(*http.Transport); ok {
+		transport = base.Clone()
+	}
+	transport.TLSClientConfig = tlsConfig
+	return &Client{
+		httpClient: &http.Client{Transport: transport},
+	}
+}
+
+// Ping sends a GET request and returns an error unless the response status is 2xx
+func (c *Client) Ping(url string, headers http.Header) error {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %v", err)
+	}
+
+	for key, val := range headers {
+		req.Header.Set(key, val[0])
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 256))
+		return fmt.Errorf("unexpected status code %d: %s", res.StatusCode, strings.TrimSpace(string(body)))
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return nil
+}
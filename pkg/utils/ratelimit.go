@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket is a rate limiter allowing bursts of up to burst events,
+// refilled with requests tokens every per duration
+type TokenBucket struct {
+	mutex  sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket returns a full bucket, burst defaults to requests
+func NewTokenBucket(requests int, per time.Duration, burst int) *TokenBucket {
+	if burst <= 0 {
+		burst = requests
+	}
+	return &TokenBucket{
+		rate:   float64(requests) / per.Seconds(),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a token is available and takes it. Waiting callers
+// reserve their token so that they are served in order.
+func (b *TokenBucket) Wait() {
+	b.mutex.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mutex.Unlock()
+
+	time.Sleep(wait)
+}
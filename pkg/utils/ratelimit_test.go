@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(2, 100*time.Millisecond, 0)
+
+	start := time.Now()
+	bucket.Wait()
+	bucket.Wait()
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Fatalf("expected the burst to pass at once, took %s", elapsed)
+	}
+	bucket.Wait()
+	bucket.Wait()
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected two more tokens to take 100ms, took %s", elapsed)
+	}
+}
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/projectdiscovery/gologger/levels"
+	"github.com/projectdiscovery/gologger/writer"
+)
+
+// RedactedValue replaces the secrets masked by a Redactor
+const RedactedValue = "****"
+
+// minSecretLength avoids masking short values that would match unrelated text
+const minSecretLength = 4
+
+// Redactor masks known secret values in text
+type Redactor struct {
+	mutex    sync.RWMutex
+	secrets  map[string]struct{}
+	replacer *strings.Replacer
+}
+
+// NewRedactor returns a redactor masking the given secrets
+func NewRedactor(secrets ...string) *Redactor {
+	r := &Redactor{secrets: make(map[string]struct{})}
+	r.Add(secrets...)
+	return r
+}
+
+// Add registers more secrets to mask, values shorter than 4 characters are ignored
+func (r *Redactor) Add(secrets ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, secret := range secrets {
+		if len(strings.TrimSpace(secret)) >= minSecretLength {
+			r.secrets[secret] = struct{}{}
+		}
+	}
+
+	// longest secrets first so that a secret containing another one is fully masked
+	values := make([]string, 0, len(r.secrets))
+	for secret := range r.secrets {
+		values = append(values, secret)
+	}
+	sort.Slice(values, func(i, j int) bool {
+		if len(values[i]) != len(values[j]) {
+			return len(values[i]) > len(values[j])
+		}
+		return values[i] < values[j]
+	})
+	pairs := make([]string, 0, 2*len(values))
+	for _, secret := range values {
+		pairs = append(pairs, secret, RedactedValue)
+	}
+	r.replacer = strings.NewReplacer(pairs...)
+}
+
+// Redact returns the text with the secrets masked
+func (r *Redactor) Redact(text string) string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	if len(r.secrets) == 0 {
+		return text
+	}
+	return r.replacer.Replace(text)
+}
+
+// Writer returns a writer masking the secrets of every write before passing
+// it to w, secrets split across writes aren't masked
+func (r *Redactor) Writer(w io.Writer) io.Writer {
+	return &redactWriter{redactor: r, writer: w}
+}
+
+// LogWriter returns a gologger writer masking the secrets of every log line
+func (r *Redactor) LogWriter(w writer.Writer) writer.Writer {
+	return &redactLogWriter{redactor: r, writer: w}
+}
+
+type redactLogWriter struct {
+	redactor *Redactor
+	writer   writer.Writer
+}
+
+func (w *redactLogWriter) Write(data []byte, level levels.Level) {
+	w.writer.Write([]byte(w.redactor.Redact(string(data))), level)
+}
+
+type redactWriter struct {
+	redactor *Redactor
+	writer   io.Writer
+}
+
+func (w *redactWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.writer, w.redactor.Redact(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
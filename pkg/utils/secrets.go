@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/google/shlex"
+	"go.uber.org/multierr"
+	"gopkg.in/yaml.v3"
+)
+
+// secretCommandTimeout bounds the commands run by ${cmd:...} and ${keyring:...}
+const secretCommandTimeout = 30 * time.Second
+
+var reSecretRef = regexp.MustCompile(`\$\{(env|file|cmd|keyring):([^}]+)\}`)
+
+// DefaultRedactor masks the secrets of the loaded provider config in logs,
+// errors and dry run outputs
+var DefaultRedactor = NewRedactor()
+
+// SecretRefError is a secret reference of the config that couldn't be resolved
+type SecretRefError struct {
+	Line   int
+	Column int
+	Ref    string
+	Err    error
+}
+
+// Message returns the error without its position
+func (e *SecretRefError) Message() string {
+	return fmt.Sprintf("could not resolve %s: %v", e.Ref, e.Err)
+}
+
+func (e *SecretRefError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message())
+}
+
+// ResolveSecretRefs replaces in place the secret references of the yaml
+// scalars with their values and returns the resolved values:
+//
+//	${env:NAME}               environment variable
+//	${file:/run/secrets/key}  file content, without the trailing newline
+//	${cmd:pass show notify}   output of the command, run without a shell
+//	${keyring:service/user}   password of the system keyring (secret-tool or security)
+func ResolveSecretRefs(node *yaml.Node) ([]string, error) {
+	var secrets []string
+	var errs error
+	walkScalars(node, func(scalar *yaml.Node) {
+		if !strings.Contains(scalar.Value, "${") {
+			return
+		}
+		scalar.Value = reSecretRef.ReplaceAllStringFunc(scalar.Value, func(ref string) string {
+			match := reSecretRef.FindStringSubmatch(ref)
+			value, err := resolveSecretRef(match[1], strings.TrimSpace(match[2]))
+			if err != nil {
+				errs = multierr.Append(errs, &SecretRefError{Line: scalar.Line, Column: scalar.Column, Ref: ref, Err: err})
+				return ref
+			}
+			secrets = append(secrets, value)
+			return value
+		})
+		// unquoted values are typed from their resolved value, e.g. a port
+		if scalar.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			scalar.Tag = ""
+		}
+	})
+	return secrets, errs
+}
+
+func walkScalars(node *yaml.Node, fn func(*yaml.Node)) {
+	if node == nil {
+		return
+	}
+	if node.Kind == yaml.ScalarNode {
+		fn(node)
+		return
+	}
+	for i, child := range node.Content {
+		// keys are left untouched
+		if node.Kind == yaml.MappingNode && i%2 == 0 {
+			continue
+		}
+		walkScalars(child, fn)
+	}
+}
+
+func resolveSecretRef(kind, ref string) (string, error) {
+	switch kind {
+	case "env":
+		value, ok := os.LookupEnv(ref)
+		if !ok {
+			return "", fmt.Errorf("environment variable is not set")
+		}
+		return value, nil
+	case "file":
+		data, err := os.ReadFile(ref)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	case "cmd":
+		args, err := shlex.Split(ref)
+		if err != nil {
+			return "", err
+		}
+		if len(args) == 0 {
+			return "", fmt.Errorf("empty command")
+		}
+		return runSecretCommand(args...)
+	case "keyring":
+		service, account, ok := strings.Cut(ref, "/")
+		if !ok || service == "" || account == "" {
+			return "", fmt.Errorf("expected service/account")
+		}
+		switch runtime.GOOS {
+		case "darwin":
+			return runSecretCommand("security", "find-generic-password", "-s", service, "-a", account, "-w")
+		case "linux", "freebsd", "openbsd":
+			return runSecretCommand("secret-tool", "lookup", "service", service, "account", account)
+		}
+		return "", fmt.Errorf("keyring is not supported on %s", runtime.GOOS)
+	}
+	return "", fmt.Errorf("unknown secret reference %q", kind)
+}
+
+// runSecretCommand returns the output of the command without the trailing newline
+func runSecretCommand(args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), secretCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return "", fmt.Errorf("%s timed out after %s", args[0], secretCommandTimeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return "", fmt.Errorf("%s: %v: %s", args[0], err, firstLine(message))
+		}
+		return "", fmt.Errorf("%s: %v", args[0], err)
+	}
+	value := strings.TrimRight(stdout.String(), "\r\n")
+	if value == "" {
+		return "", fmt.Errorf("%s returned an empty value", args[0])
+	}
+	return value, nil
+}
+
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/Masterminds/sprig"
+)
+
+// RenderTemplate renders a templated provider field against the message.
+// The {{data}}, {{dataJsonString}}, {{date}}, {{time}}, {{datetime}} and {{count}}
+// placeholders are supported along with the sprig functions, and when the message
+// is a JSON object its fields are available as {{ .field }}.
+func RenderTemplate(text, msg string, counter int) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	ts := time.Now()
+	text = dateTimeHelper(msg, text, ts)
+	text = dateHelper(msg, text, ts)
+	text = timeHelper(msg, text, ts)
+	text = countHelper(msg, text, counter)
+
+	funcMap := sprig.TxtFuncMap()
+	funcMap["data"] = func() string { return msg }
+	funcMap["dataJsonString"] = func() (string, error) {
+		b, err := json.Marshal(msg)
+		return string(b), err
+	}
+
+	tmpl, err := template.New("field").Funcs(funcMap).Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	data := make(map[string]interface{})
+	// plain text messages only expose the placeholder functions
+	_ = json.Unmarshal([]byte(msg), &data)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	// fields missing from the message render as empty values
+	return strings.ReplaceAll(buf.String(), "<no value>", ""), nil
+}
@@ -0,0 +1,31 @@
+package utils
+
+import "testing"
+
+func TestRenderTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		msg      string
+		expected string
+	}{
+		{name: "static", text: "notify", msg: "message", expected: "notify"},
+		{name: "data placeholder", text: "found: {{data}}", msg: "{{ .template }}", expected: "found: {{ .template }}"},
+		{name: "count placeholder", text: "#{{count}}", msg: "message", expected: "#3"},
+		{name: "json escaped data", text: `{"text":{{dataJsonString}}}`, msg: `a "quoted" message`, expected: `{"text":"a \"quoted\" message"}`},
+		{name: "json field", text: "{{ .info.severity | upper }}", msg: `{"info":{"severity":"critical"}}`, expected: "CRITICAL"},
+		{name: "missing field", text: "host={{ .host }}", msg: "message", expected: "host="},
+		{name: "conditional", text: `{{ if eq .severity "critical" }}urgent{{ else }}default{{ end }}`, msg: `{"severity":"low"}`, expected: "default"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := RenderTemplate(test.text, test.msg, 3)
+			if err != nil {
+				t.Fatalf("could not render template: %s", err)
+			}
+			if got != test.expected {
+				t.Errorf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
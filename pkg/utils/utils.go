@@ -1,6 +1,8 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +28,27 @@ func FormatMessage(msg, format string, counter int) string {
 	return strings.ReplaceAll(format, defaultFormat, msg)
 }
 
+// FingerprintLabel returns the issue label standing for a rendered fingerprint.
+// A digest keeps it under the 50 characters allowed by github and free of the
+// commas separating the labels of the issue search queries.
+func FingerprintLabel(fingerprint string) string {
+	digest := sha256.Sum256([]byte(fingerprint))
+	return "notify:" + hex.EncodeToString(digest[:])[:16]
+}
+
+// Truncate shortens the value to at most maxLength characters, never
+// splitting a multi-byte character
+func Truncate(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+	return string(runes[:maxLength])
+}
+
 // SelectFormat returns the format string in the following order of precedence:
 // 1. cliFormat
 // 2. configFormat
@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	if got := Truncate("short", 10); got != "short" {
+		t.Errorf("expected short values to be kept, got %q", got)
+	}
+	got := Truncate("héllo wörld", 5)
+	if got != "héllo" || !utf8.ValidString(got) {
+		t.Errorf("expected 5 characters, got %q", got)
+	}
+	if got := Truncate("日本語のトピック", 3); got != "日本語" {
+		t.Errorf("expected 3 characters, got %q", got)
+	}
+}
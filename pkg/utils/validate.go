@@ -0,0 +1,81 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	sliceutil "github.com/projectdiscovery/utils/slice"
+)
+
+// FieldError is a provider option error attached to the yaml key of the option
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// NewFieldError returns a FieldError for the yaml key
+func NewFieldError(field, format string, args ...interface{}) error {
+	return &FieldError{Field: field, Message: fmt.Sprintf(format, args...)}
+}
+
+// Required returns an error when the option is empty
+func Required(field, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return NewFieldError(field, "value is required")
+	}
+	return nil
+}
+
+// RequiredList returns an error when the list option is empty
+func RequiredList(field string, values []string) error {
+	if len(values) == 0 {
+		return NewFieldError(field, "at least one value is required")
+	}
+	return nil
+}
+
+// ValidURL returns an error when the option is set but isn't an absolute
+// url using one of the schemes (http and https by default). Templated
+// values are only checked once rendered and are skipped.
+func ValidURL(field, value string, schemes ...string) error {
+	if value == "" || isTemplated(value) {
+		return nil
+	}
+	if len(schemes) == 0 {
+		schemes = []string{"http", "https"}
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return NewFieldError(field, "invalid url: %v", err)
+	}
+	if !sliceutil.Contains(schemes, strings.ToLower(u.Scheme)) || u.Host == "" {
+		return NewFieldError(field, "expected an absolute %s url, got %q", strings.Join(schemes, "/"), value)
+	}
+	return nil
+}
+
+// OneOf returns an error when the option is set to a value that isn't allowed,
+// templated values are skipped. Values are matched regardless of case, the
+// providers normalise the case of the values before using them.
+func OneOf(field, value string, allowed ...string) error {
+	if value == "" || isTemplated(value) {
+		return nil
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return nil
+		}
+	}
+	return NewFieldError(field, "unsupported value %q, expected one of %s", value, strings.Join(allowed, ", "))
+}
+
+// isTemplated reports values rendered per message, or secret references
+// left unresolved which are reported on their own
+func isTemplated(value string) bool {
+	return strings.Contains(value, "{{") || strings.Contains(value, "${")
+}